@@ -2,18 +2,53 @@ package main
 
 import (
 	"log/slog"
+	"os"
 
 	"github.com/jonesrussell/gimbal/internal/game"
 )
 
+// settingsFile is the JSON file the player's settings are saved in.
+const settingsFile = "settings.json"
+
 func main() {
+	// A bad settings file is left alone, so the player can fix it by hand.
+	saveSettings := loadSettings()
+
 	speed := 0.04
 	g, err := game.NewGimlarGame(speed)
 	if err != nil {
 		slog.Error("Failed to initialize game", "error", err)
+		os.Exit(1)
 	}
 
 	if err := g.Run(); err != nil {
 		slog.Error("Failed to run game", "error", err)
+		return
+	}
+
+	if saveSettings {
+		if err := game.SaveUserSettings(settingsFile, g.UserSettings()); err != nil {
+			slog.Warn("Failed to save settings", "file", settingsFile, "error", err)
+		}
 	}
 }
+
+// loadSettings applies the saved settings to GameConfig if they are valid.
+// It reports whether they were applied.
+func loadSettings() bool {
+	settings, err := game.LoadUserSettings(settingsFile)
+	if err != nil {
+		slog.Warn("Ignoring saved settings", "file", settingsFile, "error", err)
+		return false
+	}
+
+	cfg := game.GameConfig
+	settings.Apply(&cfg)
+	if err := cfg.Validate(); err != nil {
+		slog.Warn("Ignoring saved settings", "file", settingsFile, "error", err)
+		return false
+	}
+
+	game.GameConfig = cfg
+	return true
+}
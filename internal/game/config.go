@@ -0,0 +1,174 @@
+package game
+
+import (
+	"fmt"
+	"image"
+	"time"
+)
+
+// ControlScheme selects how the player's ship is steered.
+type ControlScheme string
+
+const (
+	// ControlSchemeOrbital moves the player around its orbit with left and right.
+	ControlSchemeOrbital ControlScheme = "orbital"
+	// ControlSchemeFreeFlight moves the player in eight directions within the screen.
+	ControlSchemeFreeFlight ControlScheme = "free-flight"
+)
+
+// Validate checks that the control scheme is known.
+func (c ControlScheme) Validate() error {
+	switch c {
+	case ControlSchemeOrbital, ControlSchemeFreeFlight:
+		return nil
+	}
+	return fmt.Errorf("unknown control scheme %q", c)
+}
+
+// Config holds the tunable gameplay settings.
+type Config struct {
+	// OrbitRadiusRatio is the player's orbit radius as a fraction of half the screen height.
+	OrbitRadiusRatio float64
+	// OrbitCenterOffset moves the center of the player's orbit away from the center of the screen.
+	OrbitCenterOffset image.Point
+
+	// InnerDeadRadius is the smallest orbit radius the player can be placed on.
+	// It keeps the player away from the center, where the orbital geometry degenerates,
+	// and free flight can't enter it either.
+	InnerDeadRadius float64
+
+	// PlayerHitboxWidth and PlayerHitboxHeight size the player's collision object.
+	// They are usually smaller than the drawn sprite, as is common in arcade shooters.
+	PlayerHitboxWidth  float64
+	PlayerHitboxHeight float64
+
+	// ReverseControlsDuration is how long the reverse-controls hazard inverts the player's orbital input.
+	ReverseControlsDuration time.Duration
+
+	// TrainingOverlay shows control hints when the game starts.
+	TrainingOverlay bool
+	// TrainingOverlayDuration is how long the control hints stay up before fading out.
+	TrainingOverlayDuration time.Duration
+	// TrainingSeenFile records that the training overlay has been shown, so it only appears
+	// on the first playthrough. The overlay is shown every time when it is empty.
+	TrainingSeenFile string
+
+	// FrameTimeSmoothing is the weight, between 0 and 1, given to each new sample in the
+	// debug overlay's frame-time moving average. Smaller values give a steadier reading.
+	FrameTimeSmoothing float64
+
+	// KeyBindingsFile is the JSON file the key bindings are loaded from.
+	// The default bindings are used when the file does not exist.
+	KeyBindingsFile string
+	// KeyBindings, when set, are used instead of the bindings in KeyBindingsFile.
+	KeyBindings KeyBindings
+
+	// Resolution is the initial window size.
+	Resolution Resolution
+	// Fullscreen starts the game in fullscreen mode.
+	Fullscreen bool
+	// WindowTitle is the title of the game window.
+	WindowTitle string
+	// WindowIcon is the path of the window icon within the embedded assets.
+	// The window keeps the platform's default icon when it is empty or can't be loaded.
+	WindowIcon string
+
+	// Palette names the color palette used for drawing, e.g. PaletteColorblind.
+	Palette string
+
+	// ReduceMotion tames background motion for players sensitive to it.
+	ReduceMotion bool
+
+	// StarCount is the total number of stars in the star field, spread evenly across its layers.
+	StarCount int
+	// StarLayers are the depth layers of the star field, nearest first.
+	StarLayers []StarLayer
+
+	// ControlScheme selects how the player's ship is steered.
+	ControlScheme ControlScheme
+	// FreeFlightSpeed is how far, in pixels per update, the player moves in free flight.
+	FreeFlightSpeed float64
+}
+
+// DefaultConfig returns the gameplay settings used when nothing else is configured.
+func DefaultConfig() Config {
+	return Config{
+		OrbitRadiusRatio: 0.75,
+
+		InnerDeadRadius:    32,
+		PlayerHitboxWidth:  playerWidth,
+		PlayerHitboxHeight: playerHeight,
+
+		ReverseControlsDuration: 5 * time.Second,
+
+		TrainingOverlay:         true,
+		TrainingOverlayDuration: 8 * time.Second,
+		TrainingSeenFile:        "training.seen",
+
+		FrameTimeSmoothing: 0.1,
+
+		KeyBindingsFile: "keybindings.json",
+
+		Resolution:  Resolution{Width: screenWidth, Height: screenHeight},
+		WindowTitle: defaultWindowTitle,
+		WindowIcon:  "assets/player.png",
+
+		Palette: PaletteDefault,
+
+		StarCount: 100,
+		StarLayers: []StarLayer{
+			{SpeedScale: 1, SizeScale: 1, Brightness: 1},
+			{SpeedScale: 0.6, SizeScale: 0.7, Brightness: 0.7},
+			{SpeedScale: 0.3, SizeScale: 0.4, Brightness: 0.4},
+		},
+
+		ControlScheme:   ControlSchemeOrbital,
+		FreeFlightSpeed: 3,
+	}
+}
+
+// Validate checks that the configuration can be played with.
+func (c Config) Validate() error {
+	if _, _, err := configuredOrbit(c); err != nil {
+		return err
+	}
+	if c.InnerDeadRadius < 0 {
+		return fmt.Errorf("inner dead radius must not be negative, got %v", c.InnerDeadRadius)
+	}
+	if err := validatePlayerHitbox(c.PlayerHitboxWidth, c.PlayerHitboxHeight, playerSpriteSize); err != nil {
+		return err
+	}
+	if c.ReverseControlsDuration <= 0 {
+		return fmt.Errorf("reverse controls duration must be greater than zero, got %v", c.ReverseControlsDuration)
+	}
+	if c.TrainingOverlayDuration <= 0 {
+		return fmt.Errorf("training overlay duration must be greater than zero, got %v", c.TrainingOverlayDuration)
+	}
+	if c.FrameTimeSmoothing <= 0 || c.FrameTimeSmoothing > 1 {
+		return fmt.Errorf("frame time smoothing must be greater than 0 and at most 1, got %v", c.FrameTimeSmoothing)
+	}
+	if c.KeyBindings != nil {
+		if err := c.KeyBindings.Validate(); err != nil {
+			return err
+		}
+	}
+	if err := c.Resolution.Validate(); err != nil {
+		return err
+	}
+	if _, err := PaletteByName(c.Palette); err != nil {
+		return err
+	}
+	if err := validateStarField(c.StarCount, c.StarLayers); err != nil {
+		return err
+	}
+	if err := c.ControlScheme.Validate(); err != nil {
+		return err
+	}
+	if c.FreeFlightSpeed <= 0 {
+		return fmt.Errorf("free flight speed must be greater than zero, got %v", c.FreeFlightSpeed)
+	}
+	return nil
+}
+
+// GameConfig is the active gameplay configuration.
+var GameConfig = DefaultConfig()
@@ -0,0 +1,50 @@
+package game
+
+import (
+	"image"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*Config)
+		wantErr bool
+	}{
+		{name: "default", modify: func(*Config) {}},
+		{name: "free flight", modify: func(c *Config) { c.ControlScheme = ControlSchemeFreeFlight }},
+		{name: "small hitbox", modify: func(c *Config) { c.PlayerHitboxWidth, c.PlayerHitboxHeight = 6, 4 }},
+		{name: "orbit leaves the screen", modify: func(c *Config) { c.OrbitRadiusRatio = 1.5 }, wantErr: true},
+		{name: "orbit center off screen", modify: func(c *Config) { c.OrbitCenterOffset = image.Point{X: screenWidth / 2} }, wantErr: true},
+		{name: "negative inner dead radius", modify: func(c *Config) { c.InnerDeadRadius = -1 }, wantErr: true},
+		{name: "zero hitbox width", modify: func(c *Config) { c.PlayerHitboxWidth = 0 }, wantErr: true},
+		{name: "sub-pixel hitbox height", modify: func(c *Config) { c.PlayerHitboxHeight = 0.5 }, wantErr: true},
+		{name: "hitbox wider than the sprite", modify: func(c *Config) { c.PlayerHitboxWidth = 100 }, wantErr: true},
+		{name: "hitbox taller than the sprite", modify: func(c *Config) { c.PlayerHitboxHeight = 100 }, wantErr: true},
+		{name: "zero reverse controls duration", modify: func(c *Config) { c.ReverseControlsDuration = 0 }, wantErr: true},
+		{name: "negative training overlay duration", modify: func(c *Config) { c.TrainingOverlayDuration = -1 }, wantErr: true},
+		{name: "zero frame time smoothing", modify: func(c *Config) { c.FrameTimeSmoothing = 0 }, wantErr: true},
+		{name: "negative frame time smoothing", modify: func(c *Config) { c.FrameTimeSmoothing = -0.1 }, wantErr: true},
+		{name: "frame time smoothing above one", modify: func(c *Config) { c.FrameTimeSmoothing = 1.5 }, wantErr: true},
+		{name: "conflicting key bindings", modify: func(c *Config) { c.KeyBindings = KeyBindings{ActionMoveLeft: ebiten.KeyRight} }, wantErr: true},
+		{name: "resolution too small", modify: func(c *Config) { c.Resolution = Resolution{Width: 10, Height: 10} }, wantErr: true},
+		{name: "unknown palette", modify: func(c *Config) { c.Palette = "sepia" }, wantErr: true},
+		{name: "no stars", modify: func(c *Config) { c.StarCount = 0 }, wantErr: true},
+		{name: "unknown control scheme", modify: func(c *Config) { c.ControlScheme = "joystick" }, wantErr: true},
+		{name: "zero free flight speed", modify: func(c *Config) { c.FreeFlightSpeed = 0 }, wantErr: true},
+		{name: "negative free flight speed", modify: func(c *Config) { c.FreeFlightSpeed = -3 }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			tt.modify(&cfg)
+
+			err := cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
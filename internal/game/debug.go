@@ -2,7 +2,7 @@ package game
 
 import (
 	"fmt"
-	"image/color"
+	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
@@ -11,6 +11,11 @@ import (
 
 const (
 	debugGridSpacing = 32
+	debugToggleKey   = ebiten.KeyF3
+
+	// slowMotionKey toggles slow motion while debug mode is on.
+	slowMotionKey   = ebiten.KeyF4
+	slowMotionScale = 0.25
 )
 
 // DebugPrintStar prints the debug information for a star.
@@ -24,17 +29,80 @@ func DebugPrintStar(star Star) {
 func DrawDebugGridOverlay(screen *ebiten.Image) {
 	if Debug {
 		for i := 0; i < screenWidth; i += debugGridSpacing {
-			vector.StrokeLine(screen, float32(i), 0, float32(i), float32(screenHeight), 1, color.White, false)
+			vector.StrokeLine(screen, float32(i), 0, float32(i), float32(screenHeight), 1, activePalette().DebugGrid, false)
 		}
 		for i := 0; i < screenHeight; i += debugGridSpacing {
-			vector.StrokeLine(screen, 0, float32(i), float32(screenWidth), float32(i), 1, color.White, false)
+			vector.StrokeLine(screen, 0, float32(i), float32(screenWidth), float32(i), 1, activePalette().DebugGrid, false)
 		}
 	}
 }
 
+// frameTimeAverage is an exponential moving average of frame times.
+type frameTimeAverage struct {
+	smoothing float64
+	average   time.Duration
+	primed    bool
+}
+
+// Add folds a frame time sample into the average and returns the new average.
+// The first sample seeds the average directly.
+func (a *frameTimeAverage) Add(sample time.Duration) time.Duration {
+	if !a.primed {
+		a.average = sample
+		a.primed = true
+		return a.average
+	}
+
+	a.average += time.Duration(a.smoothing * float64(sample-a.average))
+	return a.average
+}
+
+// Average returns the current smoothed frame time.
+func (a *frameTimeAverage) Average() time.Duration {
+	return a.average
+}
+
+// updateDebugInput toggles debug mode when the debug key is pressed, toggles
+// slow motion in debug mode, and requests a screenshot of the next frame when
+// the screenshot key is pressed.
+func (g *GimlarGame) updateDebugInput() {
+	if g.input.WasKeyJustPressed(debugToggleKey) {
+		Debug = !Debug
+	}
+	if g.input.WasKeyJustPressed(slowMotionKey) && Debug {
+		g.slowMotion = !g.slowMotion
+	}
+	if g.input.WasKeyJustPressed(screenshotKey) {
+		g.screenshotPending = true
+	}
+}
+
+// timeScale returns how fast game time runs relative to real time.
+// Slow motion only applies while debug mode is on.
+func (g *GimlarGame) timeScale() float64 {
+	if Debug && g.slowMotion {
+		return slowMotionScale
+	}
+	return 1
+}
+
+// sampleFrameTime folds the time since the previous drawn frame into the frame time average.
+// It runs on every frame, so the average doesn't jump when the debug overlay is shown again.
+func (g *GimlarGame) sampleFrameTime(now time.Time) {
+	if !g.lastDraw.IsZero() {
+		g.frameTime.Add(now.Sub(g.lastDraw))
+	}
+	g.lastDraw = now
+}
+
 func (g *GimlarGame) DrawDebugInfo(screen *ebiten.Image) {
-	// Print the current FPS
-	ebitenutil.DebugPrint(screen, fmt.Sprintf("FPS: %0.2f", ebiten.ActualFPS()))
+	// Print the current FPS and frame time
+	info := fmt.Sprintf("FPS: %0.2f\nFrame: %0.2fms",
+		ebiten.ActualFPS(), float64(g.frameTime.Average().Microseconds())/1000)
+	if scale := g.timeScale(); scale != 1 {
+		info += fmt.Sprintf("\nSLOW-MO %gx", scale)
+	}
+	ebitenutil.DebugPrint(screen, info)
 
 	// Draw grid overlay
 	g.DrawDebugGrid(screen)
@@ -43,9 +111,9 @@ func (g *GimlarGame) DrawDebugInfo(screen *ebiten.Image) {
 func (g *GimlarGame) DrawDebugGrid(screen *ebiten.Image) {
 	// Draw grid overlay
 	for i := 0; i < screenWidth; i += debugGridSpacing {
-		vector.StrokeLine(screen, float32(i), 0, float32(i), float32(screenHeight), 1, color.White, false)
+		vector.StrokeLine(screen, float32(i), 0, float32(i), float32(screenHeight), 1, activePalette().DebugGrid, false)
 	}
 	for i := 0; i < screenHeight; i += debugGridSpacing {
-		vector.StrokeLine(screen, 0, float32(i), float32(screenWidth), float32(i), 1, color.White, false)
+		vector.StrokeLine(screen, 0, float32(i), float32(screenWidth), float32(i), 1, activePalette().DebugGrid, false)
 	}
 }
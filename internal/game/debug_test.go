@@ -0,0 +1,135 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFrameTimeAverage_convergesToConstantInput(t *testing.T) {
+	avg := frameTimeAverage{smoothing: 0.1}
+
+	avg.Add(50 * time.Millisecond)
+	for i := 0; i < 200; i++ {
+		avg.Add(16 * time.Millisecond)
+	}
+
+	assert.InDelta(t, float64(16*time.Millisecond), float64(avg.Average()), float64(10*time.Microsecond))
+}
+
+func TestFrameTimeAverage_stepResponse(t *testing.T) {
+	avg := frameTimeAverage{smoothing: 0.25}
+
+	// The first sample seeds the average
+	assert.Equal(t, 16*time.Millisecond, avg.Add(16*time.Millisecond))
+
+	// Each sample after a step closes the gap by the smoothing factor
+	assert.Equal(t, 20*time.Millisecond, avg.Add(32*time.Millisecond))
+	assert.Equal(t, 23*time.Millisecond, avg.Add(32*time.Millisecond))
+	assert.Equal(t, 25250*time.Microsecond, avg.Add(32*time.Millisecond))
+}
+
+func TestDraw_samplesFrameTimeWithoutDebug(t *testing.T) {
+	withConfig(t)
+
+	prevDebug := Debug
+	defer func() {
+		Debug = prevDebug
+	}()
+
+	game, err := NewGimlarGame(1.0)
+	if err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+
+	// Frames drawn with the overlay hidden still feed the average, so showing
+	// it again doesn't count the hidden time as a single frame
+	Debug = false
+	screen := ebiten.NewImage(screenWidth, screenHeight)
+	game.Draw(screen)
+	game.Draw(screen)
+
+	assert.False(t, game.lastDraw.IsZero())
+	assert.True(t, game.frameTime.primed)
+}
+
+func TestUpdateDebugInput_heldKeyTogglesOnce(t *testing.T) {
+	prevDebug := Debug
+	defer func() {
+		Debug = prevDebug
+	}()
+
+	input := NewMockHandler()
+	g := &GimlarGame{input: input}
+	Debug = false
+
+	// Holding the key across several frames only toggles once
+	input.PressKey(debugToggleKey)
+	for i := 0; i < 3; i++ {
+		input.PressKey(debugToggleKey)
+		g.updateDebugInput()
+		input.NextFrame()
+	}
+	assert.True(t, Debug)
+
+	// Releasing and pressing again toggles back
+	input.ReleaseKey(debugToggleKey)
+	input.PressKey(debugToggleKey)
+	g.updateDebugInput()
+	assert.False(t, Debug)
+}
+
+func TestUpdate_slowMotion(t *testing.T) {
+	prevDebug := Debug
+	defer func() {
+		Debug = prevDebug
+	}()
+	withConfig(t)
+
+	game, err := NewGimlarGame(1.0)
+	if err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+	input := NewMockHandler()
+	game.SetInputHandler(input)
+	input.PressKey(ebiten.KeyLeft)
+
+	// A single star moving right from the center, one pixel per step
+	const frames = 41
+	start := Star{X: float64(screenWidth) / 2, Y: float64(screenHeight) / 2, Angle: 0, Speed: float64(ebiten.TPS())}
+	run := func() (starMoved, playerTurned float64) {
+		game.stars = []Star{start}
+		viewAngle, trainingTicks := game.player.viewAngle, game.training.ticksLeft
+		for i := 0; i < frames; i++ {
+			if err := game.RunHeadless(1); err != nil {
+				t.Fatalf("RunHeadless() error = %v", err)
+			}
+			input.NextFrame()
+		}
+
+		// The training overlay fades in real time either way
+		assert.Equal(t, trainingTicks-frames, game.training.ticksLeft)
+
+		return game.stars[0].X - start.X, viewAngle - game.player.viewAngle
+	}
+
+	// Slow motion can't be enabled outside debug mode
+	Debug = false
+	input.PressKey(slowMotionKey)
+	normalStar, normalTurn := run()
+	assert.Equal(t, 1.0, game.timeScale())
+	assert.InDelta(t, frames, normalStar, 1e-3)
+	assert.InDelta(t, frames*AngleStep, normalTurn, 1e-9)
+
+	// Slow motion still runs a step every frame, but each one moves the stars and
+	// the player a shorter way
+	Debug = true
+	input.ReleaseKey(slowMotionKey)
+	input.PressKey(slowMotionKey)
+	slowStar, slowTurn := run()
+	assert.Equal(t, slowMotionScale, game.timeScale())
+	assert.InDelta(t, frames*slowMotionScale, slowStar, 1e-3)
+	assert.InDelta(t, frames*slowMotionScale*AngleStep, slowTurn, 1e-9)
+}
@@ -0,0 +1,154 @@
+package game
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"runtime/debug"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/jonesrussell/gimbal/internal/logger"
+)
+
+const (
+	defaultWindowTitle = "Gimbal"
+
+	// devVersion is the version of development builds, which weren't given a version at build time.
+	devVersion = "dev"
+)
+
+// Version is the build version, set at build time with
+// -ldflags "-X github.com/jonesrussell/gimbal/internal/game.Version=<version>".
+var Version = devVersion
+
+// Resolution is a window size in pixels. The game is always laid out at its
+// logical screen size and scaled to fill the window.
+type Resolution struct {
+	Width  int
+	Height int
+}
+
+var (
+	minResolution = Resolution{Width: screenWidth / 2, Height: screenHeight / 2}
+	maxResolution = Resolution{Width: 7680, Height: 4320}
+
+	// ResolutionPresets lists the window sizes offered to players.
+	ResolutionPresets = []Resolution{
+		{Width: 640, Height: 480},
+		{Width: 960, Height: 720},
+		{Width: 1280, Height: 960},
+		{Width: 1600, Height: 1200},
+	}
+)
+
+func (r Resolution) String() string {
+	return fmt.Sprintf("%dx%d", r.Width, r.Height)
+}
+
+// Validate checks that the resolution is within the supported window sizes.
+func (r Resolution) Validate() error {
+	if r.Width < minResolution.Width || r.Height < minResolution.Height {
+		return fmt.Errorf("resolution %v is smaller than the minimum %v", r, minResolution)
+	}
+	if r.Width > maxResolution.Width || r.Height > maxResolution.Height {
+		return fmt.Errorf("resolution %v is larger than the maximum %v", r, maxResolution)
+	}
+	return nil
+}
+
+// SetResolution resizes the game window. An invalid resolution is rejected
+// and the current window size is kept.
+func (g *GimlarGame) SetResolution(width, height int) error {
+	resolution := Resolution{Width: width, Height: height}
+	if err := resolution.Validate(); err != nil {
+		return err
+	}
+
+	ebiten.SetWindowSize(resolution.Width, resolution.Height)
+	g.resolution = resolution
+
+	return nil
+}
+
+// Resolution returns the current window size.
+func (g *GimlarGame) Resolution() Resolution {
+	return g.resolution
+}
+
+// SetFullscreen switches between windowed and fullscreen mode.
+func (g *GimlarGame) SetFullscreen(fullscreen bool) {
+	ebiten.SetFullscreen(fullscreen)
+	g.fullscreen = fullscreen
+}
+
+// IsFullscreen reports whether the game is in fullscreen mode.
+func (g *GimlarGame) IsFullscreen() bool {
+	return ebiten.IsFullscreen()
+}
+
+// windowTitle returns the window title, falling back to the default title when
+// none is configured. Development builds are marked with the VCS revision they
+// were built from, found in the build settings, e.g. "Gimbal (dev 1a2b3c4)".
+func windowTitle(title, version string, settings []debug.BuildSetting) string {
+	if title == "" {
+		title = defaultWindowTitle
+	}
+	if version != devVersion {
+		return title
+	}
+
+	var revision, modified string
+	for _, setting := range settings {
+		switch setting.Key {
+		case "vcs.revision":
+			revision = setting.Value
+		case "vcs.modified":
+			modified = setting.Value
+		}
+	}
+	if revision == "" {
+		return fmt.Sprintf("%s (%s)", title, version)
+	}
+
+	if len(revision) > 7 {
+		revision = revision[:7]
+	}
+	if modified == "true" {
+		revision += "-dirty"
+	}
+	return fmt.Sprintf("%s (%s %s)", title, version, revision)
+}
+
+// loadWindowIcon decodes the window icon from the embedded assets.
+func loadWindowIcon(path string) (image.Image, error) {
+	data, err := assets.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load window icon: %w", err)
+	}
+
+	icon, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode window icon: %w", err)
+	}
+
+	return icon, nil
+}
+
+// setupWindow sets the window title and icon from the config.
+func setupWindow() {
+	var settings []debug.BuildSetting
+	if info, ok := debug.ReadBuildInfo(); ok {
+		settings = info.Settings
+	}
+	ebiten.SetWindowTitle(windowTitle(GameConfig.WindowTitle, Version, settings))
+
+	if GameConfig.WindowIcon == "" {
+		return
+	}
+	icon, err := loadWindowIcon(GameConfig.WindowIcon)
+	if err != nil {
+		logger.GlobalLogger.Warn("Using the default window icon", "error", err)
+		return
+	}
+	ebiten.SetWindowIcon([]image.Image{icon})
+}
@@ -0,0 +1,85 @@
+package game
+
+import (
+	"runtime/debug"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolution_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		resolution Resolution
+		wantErr    bool
+	}{
+		{name: "logical screen size", resolution: Resolution{Width: screenWidth, Height: screenHeight}},
+		{name: "minimum size", resolution: minResolution},
+		{name: "maximum size", resolution: maxResolution},
+		{name: "too narrow", resolution: Resolution{Width: minResolution.Width - 1, Height: screenHeight}, wantErr: true},
+		{name: "too short", resolution: Resolution{Width: screenWidth, Height: minResolution.Height - 1}, wantErr: true},
+		{name: "too large", resolution: Resolution{Width: maxResolution.Width + 1, Height: screenHeight}, wantErr: true},
+		{name: "zero", resolution: Resolution{}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.resolution.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Resolution.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	for _, preset := range ResolutionPresets {
+		assert.NoError(t, preset.Validate(), "preset %v", preset)
+	}
+}
+
+func TestSetResolution_rejectsInvalid(t *testing.T) {
+	withConfig(t)
+
+	game, err := NewGimlarGame(1.0)
+	if err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+
+	assert.NoError(t, game.SetResolution(960, 720))
+	assert.Equal(t, Resolution{Width: 960, Height: 720}, game.Resolution())
+
+	assert.Error(t, game.SetResolution(10, 10))
+	assert.Equal(t, Resolution{Width: 960, Height: 720}, game.Resolution())
+}
+
+func TestWindowTitle(t *testing.T) {
+	revision := []debug.BuildSetting{{Key: "vcs.revision", Value: "1a2b3c4d5e6f"}}
+	modified := []debug.BuildSetting{{Key: "vcs.revision", Value: "1a2b3c4d5e6f"}, {Key: "vcs.modified", Value: "true"}}
+
+	tests := []struct {
+		name     string
+		title    string
+		version  string
+		settings []debug.BuildSetting
+		want     string
+	}{
+		{name: "release", title: "Gimbal", version: "v0.1.0", settings: revision, want: "Gimbal"},
+		{name: "development", title: "Gimbal", version: devVersion, settings: revision, want: "Gimbal (dev 1a2b3c4)"},
+		{name: "uncommitted changes", title: "Gimbal", version: devVersion, settings: modified, want: "Gimbal (dev 1a2b3c4-dirty)"},
+		{name: "no revision", title: "Gimbal", version: devVersion, want: "Gimbal (dev)"},
+		{name: "custom title", title: "Gyruss Clone", version: devVersion, settings: revision, want: "Gyruss Clone (dev 1a2b3c4)"},
+		{name: "empty title", title: "", version: "v0.1.0", want: defaultWindowTitle},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, windowTitle(tt.title, tt.version, tt.settings))
+		})
+	}
+}
+
+func TestLoadWindowIcon(t *testing.T) {
+	icon, err := loadWindowIcon(DefaultConfig().WindowIcon)
+	assert.NoError(t, err)
+	assert.NotNil(t, icon)
+
+	_, err = loadWindowIcon("assets/missing.png")
+	assert.Error(t, err)
+}
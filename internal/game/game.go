@@ -8,6 +8,7 @@ import (
 	"image/color"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/jonesrussell/gimbal/internal/logger"
@@ -35,22 +36,57 @@ var (
 )
 
 type GimlarGame struct {
+	input  InputHandlerInterface
 	player *Player
 	stars  []Star
 	speed  float64
 	space  *resolv.Space
 	prevX  float64
 	prevY  float64
+
+	training *TrainingOverlay
+
+	frameTime frameTimeAverage
+	lastDraw  time.Time
+
+	resolution Resolution
+	fullscreen bool
+
+	starLayers []StarLayer
+
+	// screenshotPending requests a screenshot of the next drawn frame.
+	screenshotPending bool
+	// slowMotion slows down the game world while debug mode is on.
+	slowMotion bool
+
+	timestep   fixedTimestep
+	lastUpdate time.Time
+	// clock returns the current time. Tests replace it to control the frame times.
+	clock func() time.Time
 }
 
 func init() {
 	// Create a single star image that will be used for all stars
 	starImage = ebiten.NewImage(1, 1)
 	starImage.Fill(color.White)
+
+	// Measure the player's sprite, which the orbit and free flight keep on screen
+	playerSpriteSize = resolv.Vector{X: playerWidth, Y: playerHeight}
+	data, err := assets.ReadFile("assets/player.png")
+	if err == nil {
+		var config image.Config
+		if config, _, err = image.DecodeConfig(bytes.NewReader(data)); err == nil {
+			playerSpriteSize = resolv.Vector{X: float64(config.Width), Y: float64(config.Height)}.Scale(playerSpriteScale)
+		}
+	}
+	if err != nil {
+		logger.GlobalLogger.Error("Failed to measure player sprite", "error", err)
+	}
 }
 
 func NewGimlarGame(speed float64) (*GimlarGame, error) {
 	Debug, _ = strconv.ParseBool(os.Getenv("DEBUG"))
+	step := time.Second / time.Duration(ebiten.TPS())
 
 	g := &GimlarGame{
 		player: &Player{},
@@ -59,15 +95,44 @@ func NewGimlarGame(speed float64) (*GimlarGame, error) {
 		space:  &resolv.Space{},
 		prevX:  0,
 		prevY:  0,
+
+		frameTime:  frameTimeAverage{smoothing: GameConfig.FrameTimeSmoothing},
+		resolution: GameConfig.Resolution,
+		fullscreen: GameConfig.Fullscreen,
+		starLayers: append([]StarLayer(nil), GameConfig.StarLayers...),
+		timestep: fixedTimestep{
+			step:     step,
+			maxSteps: maxStepsPerUpdate,
+			// Start half a step in, so frames arriving a little early or late
+			// still run one step each instead of alternating between zero and two
+			accumulator: step / 2,
+		},
+		clock: time.Now,
+	}
+
+	if err := GameConfig.Validate(); err != nil {
+		return nil, err
+	}
+	if err := applyOrbitConfig(GameConfig); err != nil {
+		return nil, err
 	}
 
 	// Initialize stars
 	if starImage == nil {
 		return nil, fmt.Errorf("starImage is not loaded")
 	}
-	g.stars = initializeStars(100, starImage)
+	g.stars = initializeStars(GameConfig.StarCount, len(g.starLayers), starImage)
 
-	handler := &InputHandler{}
+	bindings := GameConfig.KeyBindings
+	if bindings == nil {
+		var err error
+		if bindings, err = LoadKeyBindings(GameConfig.KeyBindingsFile); err != nil {
+			logger.GlobalLogger.Warn("Ignoring saved key bindings", "file", GameConfig.KeyBindingsFile, "error", err)
+			bindings = DefaultKeyBindings()
+		}
+	}
+	handler := &InputHandler{Bindings: bindings}
+	g.input = handler
 
 	// Load the player sprite.
 	imageData, rfErr := assets.ReadFile("assets/player.png")
@@ -90,47 +155,128 @@ func NewGimlarGame(speed float64) (*GimlarGame, error) {
 	g.space = resolv.NewSpace(screenWidth, screenHeight, playerWidth, playerHeight)
 	g.space.Add(g.player.Object)
 
+	// Only show the control hints on the first playthrough
+	if GameConfig.TrainingOverlay && !trainingSeen(GameConfig.TrainingSeenFile) {
+		g.training = NewTrainingOverlay(bindings)
+	}
+
 	return g, nil
 }
 
 func (g *GimlarGame) Run() error {
-	ebiten.SetWindowSize(screenWidth, screenHeight)
+	if err := g.SetResolution(GameConfig.Resolution.Width, GameConfig.Resolution.Height); err != nil {
+		return err
+	}
+	g.SetFullscreen(GameConfig.Fullscreen)
+	setupWindow()
 	return ebiten.RunGame(g)
 }
 
+// RunHeadless runs the given number of frames without opening a window. A display is
+// still needed, since ebiten sets up its window system when the package is loaded.
+// Each frame lasts one fixed step of real time, which runs one step.
+// It stops at and returns the first update error.
+func (g *GimlarGame) RunHeadless(frames int) error {
+	if frames < 0 {
+		return fmt.Errorf("frames must not be negative, got %d", frames)
+	}
+
+	for i := 0; i < frames; i++ {
+		if err := g.update(g.timestep.step); err != nil {
+			return fmt.Errorf("frame %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
 func (g *GimlarGame) Layout(outsideWidth, outsideHeight int) (int, int) {
 	return screenWidth, screenHeight
 }
 
+// Update runs as many fixed simulation steps as the real time since the
+// previous update calls for.
 func (g *GimlarGame) Update() error {
-	// Update the stars
-	g.updateStars()
+	now := g.clock()
+	elapsed := g.timestep.step // The first update runs a single step
+	if !g.lastUpdate.IsZero() {
+		elapsed = now.Sub(g.lastUpdate)
+	}
+	g.lastUpdate = now
 
-	// Update the player's state
-	g.player.Update()
-	g.player.updatePosition()
+	// Resume after a stall as if it were the first update, instead of jumping ahead
+	if g.timestep.Stalled(elapsed) {
+		elapsed = g.timestep.step
+	}
+
+	return g.update(elapsed)
+}
+
+// update handles the frame's input and advances the game by elapsed real time in fixed steps.
+func (g *GimlarGame) update(elapsed time.Duration) error {
+	// Handle the debug keys
+	g.updateDebugInput()
+
+	for steps := g.timestep.Advance(elapsed); steps > 0; steps-- {
+		g.step()
+	}
+
+	// The control hints are UI, so they fade out in real time, once per frame
+	if g.training != nil {
+		g.training.Update(g.player.input)
+		if g.training.Done() {
+			if err := markTrainingSeen(GameConfig.TrainingSeenFile); err != nil {
+				logger.GlobalLogger.Error("Failed to save training state", "error", err)
+			}
+			g.training = nil
+		}
+	}
 
 	// Log the player's position after updating if it has changed
-	if g.player.Object.Position.X != g.prevX || g.player.Object.Position.Y != g.prevY {
-		logger.GlobalLogger.Debug("Player position after update", "X", g.player.Object.Position.X, "Y", g.player.Object.Position.Y)
-		g.prevX = g.player.Object.Position.X
-		g.prevY = g.player.Object.Position.Y
+	if position := g.player.Position(); position.X != g.prevX || position.Y != g.prevY {
+		logger.GlobalLogger.Debug("Player position after update", "X", position.X, "Y", position.Y)
+		g.prevX = position.X
+		g.prevY = position.Y
 	}
 
 	return nil
 }
 
+// step advances the game by one fixed step. Slow motion shortens the game time
+// that passes in it, so the game still updates once per frame.
+func (g *GimlarGame) step() {
+	scale := g.timeScale()
+
+	// Update the stars by one step of game time
+	g.updateStars(g.timestep.step.Seconds() * scale)
+
+	// Update the player's state
+	g.player.update(scale)
+	g.player.updatePosition()
+}
+
 func (g *GimlarGame) Draw(screen *ebiten.Image) {
+	// Track the smoothed time between drawn frames
+	g.sampleFrameTime(time.Now())
+
 	// Draw the stars
 	g.drawStars(screen)
 
 	// Draw the player
 	g.drawPlayer(screen)
 
+	// Draw the control hints for new players
+	if g.training != nil {
+		g.training.Draw(screen)
+	}
+
 	// Draw debug info if debug is true
 	if Debug {
 		g.DrawDebugInfo(screen)
 	}
+
+	// Save the frame if a screenshot was requested
+	g.captureScreenshot(screen)
 }
 
 func (g *GimlarGame) drawPlayer(screen *ebiten.Image) {
@@ -138,6 +284,23 @@ func (g *GimlarGame) drawPlayer(screen *ebiten.Image) {
 	g.player.Draw(screen)
 }
 
+// GetRadius returns the radius of the player's orbit.
 func (g *GimlarGame) GetRadius() float64 {
-	return radius
+	return orbitRadius()
+}
+
+// SetOrbitRadius changes the radius of the player's orbit. In the orbital control scheme
+// the player immediately moves onto the new orbit, keeping its angle. The radius is
+// clamped to keep the player outside the inner dead radius and on screen.
+func (g *GimlarGame) SetOrbitRadius(r float64) error {
+	if r <= 0 {
+		return fmt.Errorf("orbit radius must be greater than zero, got %v", r)
+	}
+
+	radius = r
+	if GameConfig.ControlScheme == ControlSchemeOrbital {
+		g.player.Reposition()
+	}
+
+	return nil
 }
@@ -1,13 +1,30 @@
 package game
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/solarlune/resolv"
 	"github.com/stretchr/testify/assert"
 )
 
+// withConfig restores GameConfig and the player's orbit when the test finishes,
+// so the test can change them freely. The training state is kept in a temporary
+// file, so the training overlay is unseen and tests don't write to the package.
+func withConfig(t *testing.T) {
+	t.Helper()
+
+	prevConfig, prevRadius, prevCenter := GameConfig, radius, center
+	t.Cleanup(func() {
+		GameConfig, radius, center = prevConfig, prevRadius, prevCenter
+	})
+	GameConfig.TrainingSeenFile = filepath.Join(t.TempDir(), "training.seen")
+}
+
 func TestNewGimlarGame(t *testing.T) {
+	withConfig(t)
+
 	// Test the NewGimlarGame function
 
 	// Setup
@@ -26,6 +43,8 @@ func TestNewGimlarGame(t *testing.T) {
 }
 
 func TestUpdate(t *testing.T) {
+	withConfig(t)
+
 	// Setup
 	speed := 1.0 // Example speed value
 	game, err := NewGimlarGame(speed)
@@ -42,7 +61,27 @@ func TestUpdate(t *testing.T) {
 	// For example, checking if the player's position has changed or if the stars have moved
 }
 
+func TestRunHeadless(t *testing.T) {
+	withConfig(t)
+
+	// Setup
+	game, err := NewGimlarGame(1.0)
+	if err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+
+	// Execute
+	err = game.RunHeadless(5)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, game.player.path, 5) // The player records its position once per frame
+	assert.Error(t, game.RunHeadless(-1))
+}
+
 func TestDraw(t *testing.T) {
+	withConfig(t)
+
 	// Setup
 	speed := 1.0 // Example speed value
 	game, err := NewGimlarGame(speed)
@@ -67,6 +106,8 @@ func TestDraw(t *testing.T) {
 }
 
 func TestPlayerMovement(t *testing.T) {
+	withConfig(t)
+
 	// Setup
 	speed := 1.0 // Example speed value
 	game, err := NewGimlarGame(speed)
@@ -88,6 +129,8 @@ func TestPlayerMovement(t *testing.T) {
 }
 
 func TestStarMovement(t *testing.T) {
+	withConfig(t)
+
 	// Setup
 	speed := 1.0 // Example speed value
 	game, err := NewGimlarGame(speed)
@@ -114,3 +157,59 @@ func TestStarMovement(t *testing.T) {
 	finalPosition := stars[0].X
 	assert.NotEqual(t, initialPosition, finalPosition)
 }
+
+func TestNewGimlarGame_invalidConfig(t *testing.T) {
+	withConfig(t)
+
+	GameConfig.ControlScheme = "joystick"
+
+	_, err := NewGimlarGame(1.0)
+	assert.Error(t, err)
+}
+
+func TestPlayer_hitboxCollision(t *testing.T) {
+	withConfig(t)
+
+	GameConfig.PlayerHitboxWidth = 6
+	GameConfig.PlayerHitboxHeight = 4
+
+	game, err := NewGimlarGame(1.0)
+	if err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+
+	// Moving the player keeps its hitbox registered in the game's space
+	game.player.Reposition()
+	assert.Same(t, game.space, game.player.Object.Space)
+
+	// The hitbox spans (317, 238) to (323, 242), well within the drawn sprite
+	game.player.moveTo(screenWidth/2, screenHeight/2)
+	assert.Equal(t, resolv.Vector{X: 317, Y: 238}, game.player.Object.Position)
+	assert.Equal(t, resolv.Vector{X: 6, Y: 4}, game.player.Object.Size)
+
+	tests := []struct {
+		name string
+		x, y float64
+		want bool
+	}{
+		{name: "overlapping the top-left corner", x: 316, y: 237, want: true},
+		{name: "overlapping the bottom-right corner", x: 322, y: 241, want: true},
+		{name: "within the sprite but outside the hitbox", x: 326, y: 238},
+		{name: "below the hitbox", x: 318, y: 245},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obstacle := resolv.NewObject(tt.x, tt.y, 2, 2)
+			game.space.Add(obstacle)
+			defer game.space.Remove(obstacle)
+
+			assert.Equal(t, tt.want, game.player.Object.Overlaps(obstacle))
+			if tt.want {
+				check := game.player.Object.Check(0, 0)
+				if assert.NotNil(t, check) {
+					assert.Contains(t, check.Objects, obstacle)
+				}
+			}
+		})
+	}
+}
@@ -1,15 +1,33 @@
 package game
 
-import "github.com/hajimehoshi/ebiten/v2"
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
 
 // InputHandlerInterface defines the methods for handling input.
 type InputHandlerInterface interface {
 	IsKeyPressed(key ebiten.Key) bool
+	IsActionPressed(action Action) bool
+	// WasKeyJustPressed reports whether key went down this frame. Unlike IsKeyPressed,
+	// it is edge-triggered, so a held key only registers once.
+	WasKeyJustPressed(key ebiten.Key) bool
 }
 
 // InputHandler implements HandlerInterface for the real game.
-type InputHandler struct{}
+type InputHandler struct {
+	Bindings KeyBindings
+}
 
 func (rh *InputHandler) IsKeyPressed(key ebiten.Key) bool {
 	return ebiten.IsKeyPressed(key)
 }
+
+// IsActionPressed reports whether the key bound to action is pressed.
+func (rh *InputHandler) IsActionPressed(action Action) bool {
+	return rh.IsKeyPressed(rh.Bindings.Key(action))
+}
+
+func (rh *InputHandler) WasKeyJustPressed(key ebiten.Key) bool {
+	return inpututil.IsKeyJustPressed(key)
+}
@@ -0,0 +1,143 @@
+package game
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Action names a game input that can be bound to a key.
+type Action string
+
+const (
+	ActionMoveLeft  Action = "MoveLeft"
+	ActionMoveRight Action = "MoveRight"
+	// ActionMoveUp and ActionMoveDown are only used by the free flight control scheme.
+	ActionMoveUp   Action = "MoveUp"
+	ActionMoveDown Action = "MoveDown"
+)
+
+// reservedKeys are the keys of the built-in debug and screenshot controls, which can't be bound to actions.
+var reservedKeys = map[ebiten.Key]string{
+	debugToggleKey: "debug toggle",
+	slowMotionKey:  "slow motion",
+	screenshotKey:  "screenshot",
+}
+
+// checkReservedKey fails if key is reserved for a built-in control.
+func checkReservedKey(key ebiten.Key) error {
+	if control, ok := reservedKeys[key]; ok {
+		return fmt.Errorf("key %v is reserved for the %s control", key, control)
+	}
+	return nil
+}
+
+// KeyBindings maps each action to the key that triggers it.
+type KeyBindings map[Action]ebiten.Key
+
+// DefaultKeyBindings returns the key bindings used when no bindings file is present.
+func DefaultKeyBindings() KeyBindings {
+	return KeyBindings{
+		ActionMoveLeft:  ebiten.KeyLeft,
+		ActionMoveRight: ebiten.KeyRight,
+		ActionMoveUp:    ebiten.KeyUp,
+		ActionMoveDown:  ebiten.KeyDown,
+	}
+}
+
+// LoadKeyBindings reads key bindings from a JSON file mapping action names to key names,
+// e.g. {"MoveLeft": "A"}. Actions missing from the file keep their default key.
+// If the file does not exist, the default bindings are returned.
+func LoadKeyBindings(path string) (KeyBindings, error) {
+	bindings := DefaultKeyBindings()
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return bindings, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key bindings: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &bindings); err != nil {
+		return nil, fmt.Errorf("failed to parse key bindings: %w", err)
+	}
+
+	if err := bindings.Validate(); err != nil {
+		return nil, err
+	}
+
+	return bindings, nil
+}
+
+// Key returns the key bound to action, falling back to its default key.
+func (kb KeyBindings) Key(action Action) ebiten.Key {
+	if key, ok := kb[action]; ok {
+		return key
+	}
+	return DefaultKeyBindings()[action]
+}
+
+// effective returns the bindings merged over the defaults, which are the keys
+// Key resolves each action to.
+func (kb KeyBindings) effective() KeyBindings {
+	bindings := DefaultKeyBindings()
+	for action, key := range kb {
+		bindings[action] = key
+	}
+	return bindings
+}
+
+// Rebind binds the action named name, e.g. "MoveLeft", to key. Nil bindings start
+// from the defaults. It fails if the action is unknown, the key is reserved, or the
+// key is already bound to another action, including by default.
+func (kb *KeyBindings) Rebind(name string, key ebiten.Key) error {
+	action := Action(name)
+	if _, ok := DefaultKeyBindings()[action]; !ok {
+		return fmt.Errorf("unknown action %q", action)
+	}
+	if err := checkReservedKey(key); err != nil {
+		return err
+	}
+
+	for other, bound := range kb.effective() {
+		if other != action && bound == key {
+			return fmt.Errorf("key %v is already bound to %s", key, other)
+		}
+	}
+
+	if *kb == nil {
+		*kb = DefaultKeyBindings()
+	}
+	(*kb)[action] = key
+	return nil
+}
+
+// Validate checks that every action is known, that no reserved key is bound,
+// and that no key is bound to more than one action once the defaults fill in
+// the actions left out.
+func (kb KeyBindings) Validate() error {
+	defaults := DefaultKeyBindings()
+	for action, key := range kb {
+		if _, ok := defaults[action]; !ok {
+			return fmt.Errorf("unknown action %q", action)
+		}
+		if err := checkReservedKey(key); err != nil {
+			return err
+		}
+	}
+
+	bindings := kb.effective()
+	bound := make(map[ebiten.Key]Action, len(bindings))
+	for action, key := range bindings {
+		if other, ok := bound[key]; ok {
+			return fmt.Errorf("key %v is bound to both %s and %s", key, other, action)
+		}
+		bound[key] = action
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package game
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadKeyBindings(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    KeyBindings
+		wantErr bool
+	}{
+		{
+			name:    "override one action",
+			content: `{"MoveLeft": "A"}`,
+			want:    KeyBindings{ActionMoveLeft: ebiten.KeyA}.effective(),
+		},
+		{
+			name:    "override all actions",
+			content: `{"MoveLeft": "A", "MoveRight": "D", "MoveUp": "W", "MoveDown": "S"}`,
+			want: KeyBindings{
+				ActionMoveLeft:  ebiten.KeyA,
+				ActionMoveRight: ebiten.KeyD,
+				ActionMoveUp:    ebiten.KeyW,
+				ActionMoveDown:  ebiten.KeyS,
+			},
+		},
+		{
+			name:    "duplicate key",
+			content: `{"MoveLeft": "A", "MoveRight": "A"}`,
+			wantErr: true,
+		},
+		{
+			name:    "key already bound by default",
+			content: `{"MoveLeft": "ArrowRight"}`,
+			wantErr: true,
+		},
+		{
+			name:    "reserved key",
+			content: `{"MoveLeft": "F3"}`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown action",
+			content: `{"Jump": "Space"}`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown key",
+			content: `{"MoveLeft": "NotAKey"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "keybindings.json")
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatalf("Failed to write key bindings: %v", err)
+			}
+
+			got, err := LoadKeyBindings(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadKeyBindings() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLoadKeyBindings_missingFile(t *testing.T) {
+	got, err := LoadKeyBindings(filepath.Join(t.TempDir(), "missing.json"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultKeyBindings(), got)
+}
+
+func TestKeyBindings_Rebind(t *testing.T) {
+	bindings := DefaultKeyBindings()
+
+	assert.NoError(t, bindings.Rebind(string(ActionMoveLeft), ebiten.KeyA))
+	assert.Equal(t, ebiten.KeyA, bindings.Key(ActionMoveLeft))
+
+	// A key can't be shared between two actions
+	assert.Error(t, bindings.Rebind(string(ActionMoveRight), ebiten.KeyA))
+	assert.Equal(t, ebiten.KeyRight, bindings.Key(ActionMoveRight))
+
+	// Rebinding an action to its own key is allowed
+	assert.NoError(t, bindings.Rebind(string(ActionMoveLeft), ebiten.KeyA))
+
+	// The debug and screenshot keys are reserved
+	for _, key := range []ebiten.Key{debugToggleKey, slowMotionKey, screenshotKey} {
+		assert.Error(t, bindings.Rebind(string(ActionMoveRight), key), "key %v", key)
+	}
+	assert.Equal(t, ebiten.KeyRight, bindings.Key(ActionMoveRight))
+
+	// Actions are named by plain strings, as read from a settings screen
+	assert.NoError(t, bindings.Rebind("MoveUp", ebiten.KeyW))
+	assert.Equal(t, ebiten.KeyW, bindings.Key(ActionMoveUp))
+
+	assert.Error(t, bindings.Rebind("Jump", ebiten.KeySpace))
+}
+
+func TestKeyBindings_Rebind_nil(t *testing.T) {
+	var bindings KeyBindings
+
+	// A key bound by default can't be taken by another action
+	assert.Error(t, bindings.Rebind(string(ActionMoveLeft), ebiten.KeyRight))
+	assert.Nil(t, bindings)
+
+	// Rebinding starts from the defaults
+	assert.NoError(t, bindings.Rebind(string(ActionMoveLeft), ebiten.KeyA))
+	assert.Equal(t, KeyBindings{ActionMoveLeft: ebiten.KeyA}.effective(), bindings)
+}
+
+func TestKeyBindings_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		bindings KeyBindings
+		wantErr  bool
+	}{
+		{name: "nil", bindings: nil},
+		{name: "defaults", bindings: DefaultKeyBindings()},
+		{name: "partial", bindings: KeyBindings{ActionMoveLeft: ebiten.KeyA}},
+		{name: "swapped", bindings: KeyBindings{ActionMoveLeft: ebiten.KeyRight, ActionMoveRight: ebiten.KeyLeft}},
+		{name: "duplicate key", bindings: KeyBindings{ActionMoveLeft: ebiten.KeyA, ActionMoveRight: ebiten.KeyA}, wantErr: true},
+		{name: "key bound to a missing action by default", bindings: KeyBindings{ActionMoveLeft: ebiten.KeyRight}, wantErr: true},
+		{name: "reserved key", bindings: KeyBindings{ActionMoveLeft: debugToggleKey}, wantErr: true},
+		{name: "unknown action", bindings: KeyBindings{"Jump": ebiten.KeySpace}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.bindings.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPlayer_Update_reboundKey(t *testing.T) {
+	input := NewMockHandler()
+	input.Bindings = DefaultKeyBindings()
+	if err := input.Bindings.Rebind(string(ActionMoveLeft), ebiten.KeyA); err != nil {
+		t.Fatalf("Failed to rebind: %v", err)
+	}
+
+	image := ebiten.NewImage(600, 480)
+	p, err := NewPlayer(input, 1.0, image)
+	if err != nil {
+		t.Fatalf("Failed to create new player: %v", err)
+	}
+
+	input.PressKey(ebiten.KeyLeft)
+	p.Update()
+	assert.Equal(t, 0.0, p.direction)
+
+	input.ReleaseKey(ebiten.KeyLeft)
+	input.PressKey(ebiten.KeyA)
+	p.Update()
+	assert.Equal(t, -1.0, p.direction)
+}
+
+func TestNewGimlarGame_invalidKeyBindingsFile(t *testing.T) {
+	withConfig(t)
+
+	GameConfig.KeyBindingsFile = filepath.Join(t.TempDir(), "keybindings.json")
+	if err := os.WriteFile(GameConfig.KeyBindingsFile, []byte(`{"MoveLeft": "ArrowRight"}`), 0o600); err != nil {
+		t.Fatalf("Failed to write key bindings: %v", err)
+	}
+
+	game, err := NewGimlarGame(1.0)
+	if err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+
+	// The invalid file is ignored in favor of the default bindings
+	assert.Equal(t, DefaultKeyBindings(), game.input.(*InputHandler).Bindings)
+}
@@ -5,21 +5,46 @@ import "github.com/hajimehoshi/ebiten/v2"
 // MockHandler is a mock implementation of the input.Handler interface
 // for use in unit tests.
 type MockHandler struct {
-	pressedKeys map[ebiten.Key]bool
+	pressedKeys     map[ebiten.Key]bool
+	justPressedKeys map[ebiten.Key]bool
+	Bindings        KeyBindings
 }
 
 func NewMockHandler() *MockHandler {
-	return &MockHandler{pressedKeys: make(map[ebiten.Key]bool)}
+	return &MockHandler{
+		pressedKeys:     make(map[ebiten.Key]bool),
+		justPressedKeys: make(map[ebiten.Key]bool),
+	}
 }
 
+// PressKey presses key. A key that is not already held also reports as just pressed
+// until the next frame.
 func (mh *MockHandler) PressKey(key ebiten.Key) {
+	if !mh.pressedKeys[key] {
+		mh.justPressedKeys[key] = true
+	}
 	mh.pressedKeys[key] = true
 }
 
 func (mh *MockHandler) ReleaseKey(key ebiten.Key) {
 	mh.pressedKeys[key] = false
+	mh.justPressedKeys[key] = false
 }
 
 func (mh *MockHandler) IsKeyPressed(key ebiten.Key) bool {
 	return mh.pressedKeys[key]
 }
+
+func (mh *MockHandler) IsActionPressed(action Action) bool {
+	return mh.IsKeyPressed(mh.Bindings.Key(action))
+}
+
+// WasKeyJustPressed reports whether key was pressed in the current frame.
+func (mh *MockHandler) WasKeyJustPressed(key ebiten.Key) bool {
+	return mh.justPressedKeys[key]
+}
+
+// NextFrame moves on to the next frame. Keys stay held, but no longer report as just pressed.
+func (mh *MockHandler) NextFrame() {
+	clear(mh.justPressedKeys)
+}
@@ -0,0 +1,52 @@
+package game
+
+import (
+	"fmt"
+	"image/color"
+)
+
+// Palette holds the named colors used to draw the game.
+type Palette struct {
+	// PlayerPath is the color of the player's debug path.
+	PlayerPath color.Color
+	// PlayerHitbox is the color of the player's debug hitbox.
+	PlayerHitbox color.Color
+	// DebugGrid is the color of the debug grid overlay.
+	DebugGrid color.Color
+}
+
+const (
+	PaletteDefault    = "default"
+	PaletteColorblind = "colorblind"
+)
+
+var palettes = map[string]Palette{
+	PaletteDefault: {
+		PlayerPath:   color.RGBA{255, 0, 0, 255},
+		PlayerHitbox: color.RGBA{255, 0, 0, 255},
+		DebugGrid:    color.White,
+	},
+	// Okabe-Ito colors, which stay distinguishable for the common forms of color blindness.
+	PaletteColorblind: {
+		PlayerPath:   color.RGBA{86, 180, 233, 255},
+		PlayerHitbox: color.RGBA{230, 159, 0, 255},
+		DebugGrid:    color.RGBA{240, 228, 66, 255},
+	},
+}
+
+// PaletteByName returns the palette with the given name.
+func PaletteByName(name string) (Palette, error) {
+	palette, ok := palettes[name]
+	if !ok {
+		return Palette{}, fmt.Errorf("unknown palette %q", name)
+	}
+	return palette, nil
+}
+
+// activePalette returns the configured palette, falling back to the default palette.
+func activePalette() Palette {
+	if palette, err := PaletteByName(GameConfig.Palette); err == nil {
+		return palette
+	}
+	return palettes[PaletteDefault]
+}
@@ -0,0 +1,30 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaletteByName(t *testing.T) {
+	for _, name := range []string{PaletteDefault, PaletteColorblind} {
+		palette, err := PaletteByName(name)
+		assert.NoError(t, err, name)
+		assert.NotNil(t, palette.PlayerPath, name)
+		assert.NotNil(t, palette.PlayerHitbox, name)
+		assert.NotNil(t, palette.DebugGrid, name)
+	}
+
+	_, err := PaletteByName("sepia")
+	assert.Error(t, err)
+}
+
+func TestActivePalette(t *testing.T) {
+	withConfig(t)
+
+	GameConfig.Palette = PaletteColorblind
+	assert.Equal(t, palettes[PaletteColorblind], activePalette())
+
+	GameConfig.Palette = "sepia"
+	assert.Equal(t, palettes[PaletteDefault], activePalette())
+}
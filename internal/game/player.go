@@ -6,14 +6,25 @@ import (
 	"image"
 	"math"
 
-	"image/color"
-
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/vector"
 	"github.com/jonesrussell/gimbal/internal/logger"
 	"github.com/solarlune/resolv"
 )
 
+// playerSpriteScale is the scale the player's sprite is drawn at.
+const playerSpriteScale = 0.1
+
+// playerSpriteSize is the drawn size of the player's sprite in the embedded assets.
+var playerSpriteSize resolv.Vector
+
+// playerMargin is how far the player's center has to stay from the screen edges to keep
+// its sprite on screen, whichever way the sprite is turned. A turned sprite's corners
+// reach half its diagonal from the center.
+func playerMargin() float64 {
+	return math.Hypot(playerSpriteSize.X, playerSpriteSize.Y) / 2
+}
+
 type PlayerInput struct {
 	input InputHandlerInterface
 }
@@ -38,6 +49,9 @@ type Player struct {
 	viewAngle float64
 	direction float64
 	angle     float64
+
+	// reverseTicks counts down the updates left while the orbital controls are reversed.
+	reverseTicks int
 }
 
 // NewPlayer creates a new instance of a player with the given input handler, speed, and sprite image.
@@ -59,8 +73,13 @@ func NewPlayer(input InputHandlerInterface, speed float64, spriteImage image.Ima
 	initialAngle := math.Pi * 1.5 // 270 degrees or bottom of the screen
 
 	// calculate the initial X and Y positions of the player based on the center point and the initial angle
-	initialX := center.X + int(radius*math.Cos(initialAngle))
-	initialY := center.Y - int(radius*math.Sin(initialAngle)) - playerHeight/2
+	initialX := center.X + int(orbitRadius()*math.Cos(initialAngle))
+	initialY := center.Y - int(orbitRadius()*math.Sin(initialAngle)) - playerHeight/2
+
+	sprite := ebiten.NewImageFromImage(spriteImage)
+	if err := validatePlayerHitbox(GameConfig.PlayerHitboxWidth, GameConfig.PlayerHitboxHeight, drawnSpriteSize(sprite)); err != nil {
+		return nil, err
+	}
 
 	// create a new instance of a player with the given input handler, initial position, and sprite image
 	player := &Player{
@@ -68,10 +87,10 @@ func NewPlayer(input InputHandlerInterface, speed float64, spriteImage image.Ima
 			input: input,
 		},
 		PlayerPosition: PlayerPosition{
-			Object: resolv.NewObject(float64(initialX), float64(initialY), float64(playerWidth), float64(playerHeight)),
+			Object: newPlayerHitbox(float64(initialX), float64(initialY)),
 		},
 		PlayerSprite: PlayerSprite{
-			Sprite: ebiten.NewImageFromImage(spriteImage),
+			Sprite: sprite,
 		},
 		PlayerPath: PlayerPath{},
 		viewAngle:  initialAngle,
@@ -80,48 +99,166 @@ func NewPlayer(input InputHandlerInterface, speed float64, spriteImage image.Ima
 	return player, nil
 }
 
+// newPlayerHitbox creates the player's collision object centered on x, y using the configured hitbox size.
+func newPlayerHitbox(x, y float64) *resolv.Object {
+	hitbox := resolv.NewObject(0, 0, GameConfig.PlayerHitboxWidth, GameConfig.PlayerHitboxHeight)
+	hitbox.SetCenter(x, y)
+	hitbox.Update()
+	return hitbox
+}
+
+// drawnSpriteSize returns the size sprite is drawn at.
+func drawnSpriteSize(sprite *ebiten.Image) resolv.Vector {
+	return resolv.Vector{
+		X: float64(sprite.Bounds().Dx()) * playerSpriteScale,
+		Y: float64(sprite.Bounds().Dy()) * playerSpriteScale,
+	}
+}
+
+// validatePlayerHitbox checks that the hitbox is at least a pixel in size and fits within the drawn sprite.
+func validatePlayerHitbox(width, height float64, sprite resolv.Vector) error {
+	if width < 1 || width > sprite.X {
+		return fmt.Errorf("player hitbox width must be between 1 and the sprite width %v, got %v", sprite.X, width)
+	}
+	if height < 1 || height > sprite.Y {
+		return fmt.Errorf("player hitbox height must be between 1 and the sprite height %v, got %v", sprite.Y, height)
+	}
+	return nil
+}
+
+// Position returns the center of the player, where its sprite is drawn.
+func (player *Player) Position() resolv.Vector {
+	return player.Object.Center()
+}
+
+// moveTo centers the player's collision object on x, y, keeping it registered in its space.
+func (player *Player) moveTo(x, y float64) {
+	player.Object.SetCenter(x, y)
+	player.Object.Update()
+}
+
+// Update advances the player by one step.
 func (player *Player) Update() {
+	player.update(1)
+}
+
+// update advances the player by one step, moving it by the given fraction of its
+// normal distance per step.
+func (player *Player) update(timeScale float64) {
 	if !gameStarted {
-		logger.GlobalLogger.Debug("Player", "viewAngle", player.viewAngle, "direction", player.direction, "angle", player.angle, "X", float64(player.Object.Position.X), "Y", float64(player.Object.Position.Y))
+		logger.GlobalLogger.Debug("Player", "viewAngle", player.viewAngle, "direction", player.direction, "angle", player.angle, "X", player.Position().X, "Y", player.Position().Y)
 		gameStarted = true
 	}
 
 	oldOrientation := player.viewAngle
 	oldDirection := player.direction
 	oldAngle := player.angle
-	oldX := player.Object.Position.X
-	oldY := player.Object.Position.Y
+	oldPosition := player.Position()
 
-	if player.input.IsKeyPressed(ebiten.KeyLeft) {
+	// The reverse-controls hazard inverts the input while it is active
+	inputSign := 1.0
+	if player.reverseTicks > 0 {
+		inputSign = -1
+		player.reverseTicks--
+	}
+
+	switch GameConfig.ControlScheme {
+	case ControlSchemeFreeFlight:
+		player.updateFreeFlight(inputSign, timeScale)
+	default:
+		player.updateOrbital(inputSign, timeScale)
+	}
+	logger.GlobalLogger.Info("position", "full", player.Position())
+
+	if player.viewAngle != oldOrientation || player.direction != oldDirection || player.angle != oldAngle || player.Position() != oldPosition {
+		logger.GlobalLogger.Debug("Player", "viewAngle", player.viewAngle, "direction", player.direction, "angle", player.angle, "X", player.Position().X, "Y", player.Position().Y)
+	}
+
+	// Add the current position to the path
+	player.path = append(player.path, player.Position())
+
+	player.Object.Update()
+}
+
+// updateOrbital moves the player around its orbit.
+func (player *Player) updateOrbital(inputSign, timeScale float64) {
+	if player.input.IsActionPressed(ActionMoveLeft) {
 		player.direction = -1
-		player.viewAngle -= AngleStep
-	} else if player.input.IsKeyPressed(ebiten.KeyRight) {
+	} else if player.input.IsActionPressed(ActionMoveRight) {
 		player.direction = 1
-		player.viewAngle += AngleStep
 	} else {
 		player.direction = 0
 	}
+	player.direction *= inputSign
 
-	position := player.calculatePosition()
-	logger.GlobalLogger.Info("position", "full", position)
+	player.viewAngle += player.direction * AngleStep * timeScale
 
-	player.Object = resolv.NewObject(
-		position.X,
-		position.Y,
-		float64(playerWidth),
-		float64(playerHeight),
-	)
+	player.Reposition()
+}
 
-	player.angle = player.calculateAngle()
+// updateFreeFlight moves the player in eight directions within the screen,
+// facing the direction of movement.
+func (player *Player) updateFreeFlight(inputSign, timeScale float64) {
+	var dx, dy float64
+	if player.input.IsActionPressed(ActionMoveLeft) {
+		dx--
+	}
+	if player.input.IsActionPressed(ActionMoveRight) {
+		dx++
+	}
+	if player.input.IsActionPressed(ActionMoveUp) {
+		dy--
+	}
+	if player.input.IsActionPressed(ActionMoveDown) {
+		dy++
+	}
+	dx *= inputSign
+	dy *= inputSign
 
-	if player.viewAngle != oldOrientation || player.direction != oldDirection || player.angle != oldAngle || player.Object.Position.X != oldX || player.Object.Position.Y != oldY {
-		logger.GlobalLogger.Debug("Player", "viewAngle", player.viewAngle, "direction", player.direction, "angle", player.angle, "X", float64(player.Object.Position.X), "Y", float64(player.Object.Position.Y))
+	player.direction = dx
+	if dx == 0 && dy == 0 {
+		return
 	}
 
-	// Add the current position to the path
-	player.path = append(player.path, player.Object.Position)
+	// Normalize so diagonal movement is no faster than straight movement
+	step := GameConfig.FreeFlightSpeed * timeScale / math.Hypot(dx, dy)
+	position := player.Position()
+	margin := playerMargin()
+	x := math.Max(margin, math.Min(position.X+dx*step, screenWidth-margin))
+	y := math.Max(margin, math.Min(position.Y+dy*step, screenHeight-margin))
+
+	// Push the player back out of the inner dead radius around the orbit center
+	fromX, fromY := x-float64(center.X), y-float64(center.Y)
+	if distance := math.Hypot(fromX, fromY); distance < GameConfig.InnerDeadRadius {
+		if distance == 0 {
+			// Right on the center, back out the way the player came
+			fromX, fromY, distance = -dx, -dy, math.Hypot(dx, dy)
+		}
+		x = float64(center.X) + fromX/distance*GameConfig.InnerDeadRadius
+		y = float64(center.Y) + fromY/distance*GameConfig.InnerDeadRadius
+	}
 
-	player.Object.Update()
+	player.moveTo(x, y)
+	player.angle = math.Atan2(dy, dx) + RotationOffset
+}
+
+// Reposition places the player on its orbit at its current view angle, using the
+// current orbit radius, and turns it to face the center.
+func (player *Player) Reposition() {
+	position := player.calculatePosition()
+	player.moveTo(position.X, position.Y)
+	player.angle = player.calculateAngle()
+}
+
+// ReverseControls starts the reverse-controls hazard, which inverts the player's
+// orbital input for the configured duration.
+func (player *Player) ReverseControls() {
+	player.reverseTicks = int(math.Round(GameConfig.ReverseControlsDuration.Seconds() * float64(ebiten.TPS())))
+}
+
+// ControlsReversed reports whether the reverse-controls hazard is active.
+func (player *Player) ControlsReversed() bool {
+	return player.reverseTicks > 0
 }
 
 var prevRectX, prevRectY float64
@@ -148,7 +285,7 @@ func (player *Player) drawPath(screen *ebiten.Image) {
 			float32(player.path[i+1].X),
 			float32(player.path[i+1].Y),
 			1.0,
-			color.RGBA{255, 0, 0, 255},
+			activePalette().PlayerPath,
 			false,
 		)
 	}
@@ -156,13 +293,13 @@ func (player *Player) drawPath(screen *ebiten.Image) {
 
 func (player *Player) drawRectangle(screen *ebiten.Image) {
 	// Create a new image for the rectangle
-	rectColor := color.RGBA{255, 0, 0, 255}
+	rectColor := activePalette().PlayerHitbox
 	img := ebiten.NewImage(int(player.Object.Size.X), int(player.Object.Size.Y))
 	img.Fill(rectColor)
 
-	// Calculate the rectangle's top-left corner position
-	rectX := player.Object.Position.X - player.Object.Size.X/2
-	rectY := player.Object.Position.Y - player.Object.Size.Y/2
+	// The collision object is positioned by its top-left corner
+	rectX := player.Object.Position.X
+	rectY := player.Object.Position.Y
 
 	// Check if rectX or rectY has changed since the last call
 	if rectX != prevRectX || rectY != prevRectY {
@@ -202,12 +339,12 @@ func (player *Player) createSpriteOptions() *ebiten.DrawImageOptions {
 	spriteOp.GeoM.Translate(-someValue, -height/2)
 
 	// Scale the sprite to 1/10th size and rotate
-	spriteOp.GeoM.Scale(0.1, 0.1)
+	spriteOp.GeoM.Scale(playerSpriteScale, playerSpriteScale)
 	spriteOp.GeoM.Rotate(player.angle)
 
 	// Translate the rotated and scaled sprite to the player's position
-	spriteX := player.Object.Position.X
-	spriteY := player.Object.Position.Y
+	spriteX := player.Position().X
+	spriteY := player.Position().Y
 	spriteOp.GeoM.Translate(spriteX, spriteY)
 
 	return spriteOp
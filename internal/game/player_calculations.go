@@ -1,6 +1,8 @@
 package game
 
 import (
+	"fmt"
+	"image"
 	"math"
 
 	"github.com/solarlune/resolv"
@@ -13,9 +15,77 @@ const (
 	RotationOffset = math.Pi / 2
 )
 
+// applyOrbitConfig validates the configured orbit and makes it the player's orbit.
+func applyOrbitConfig(cfg Config) error {
+	orbitCenter, orbit, err := configuredOrbit(cfg)
+	if err != nil {
+		return err
+	}
+
+	center = orbitCenter
+	radius = orbit
+
+	return nil
+}
+
+// configuredOrbit returns the center and radius of the orbit cfg configures,
+// or an error if the orbit doesn't fit on screen.
+func configuredOrbit(cfg Config) (image.Point, float64, error) {
+	if cfg.OrbitRadiusRatio <= 0 || cfg.OrbitRadiusRatio > 1 {
+		return image.Point{}, 0, fmt.Errorf("orbit radius ratio must be in (0, 1], got %v", cfg.OrbitRadiusRatio)
+	}
+
+	offset := cfg.OrbitCenterOffset
+	if offset.X <= -screenWidth/2 || offset.X >= screenWidth/2 || offset.Y <= -screenHeight/2 || offset.Y >= screenHeight/2 {
+		return image.Point{}, 0, fmt.Errorf("orbit center offset %v is off screen", offset)
+	}
+
+	orbitCenter := image.Point{X: screenWidth / 2, Y: screenHeight / 2}.Add(offset)
+	if maxOrbitRadiusAround(orbitCenter) < cfg.InnerDeadRadius {
+		return image.Point{}, 0, fmt.Errorf("orbit center offset %v leaves no room outside the inner dead radius", offset)
+	}
+	// Free flight pushes the player out of the dead radius anywhere around the center,
+	// which has to stay on screen too
+	if cfg.ControlScheme == ControlSchemeFreeFlight && maxFreeFlightRadiusAround(orbitCenter) < cfg.InnerDeadRadius {
+		return image.Point{}, 0, fmt.Errorf("orbit center offset %v leaves no room outside the inner dead radius in free flight", offset)
+	}
+
+	orbit := float64(screenHeight/2) * cfg.OrbitRadiusRatio
+	if maxRadius := maxOrbitRadiusAround(orbitCenter); orbit > maxRadius {
+		return image.Point{}, 0, fmt.Errorf("orbit radius %v around %v leaves the screen, the most is %v", orbit, orbitCenter, maxRadius)
+	}
+
+	return orbitCenter, orbit, nil
+}
+
+// orbitRadius returns the radius of the player's orbit. It is never less than the
+// configured inner dead radius, nor so large that the player leaves the screen.
+func orbitRadius() float64 {
+	return math.Min(math.Max(radius, GameConfig.InnerDeadRadius), maxOrbitRadius())
+}
+
+// maxOrbitRadius returns the largest orbit radius that keeps the player on screen.
+func maxOrbitRadius() float64 {
+	return maxOrbitRadiusAround(center)
+}
+
+// maxOrbitRadiusAround returns the largest radius of an orbit around c that keeps the player's sprite on screen.
+func maxOrbitRadiusAround(c image.Point) float64 {
+	// The player sits playerHeight/2 above its orbit, see calculateCoordinates
+	edge := min(c.X, screenWidth-c.X, c.Y-playerHeight/2, screenHeight-c.Y+playerHeight/2)
+	return float64(edge) - playerMargin()
+}
+
+// maxFreeFlightRadiusAround returns the largest radius of a circle around c that keeps
+// the player's sprite on screen anywhere on it in free flight.
+func maxFreeFlightRadiusAround(c image.Point) float64 {
+	return float64(min(c.X, screenWidth-c.X, c.Y, screenHeight-c.Y)) - playerMargin()
+}
+
 func (player *Player) calculateCoordinates(angle float64) (int, int) {
-	x := center.X + int(radius*math.Cos(angle))
-	y := center.Y - int(radius*math.Sin(angle)) - playerHeight/2
+	r := orbitRadius()
+	x := center.X + int(r*math.Cos(angle))
+	y := center.Y - int(r*math.Sin(angle)) - playerHeight/2
 	return x, y
 }
 
@@ -25,7 +95,8 @@ func (player *Player) calculatePosition() resolv.Vector {
 }
 
 func (player *Player) calculateAngle() float64 {
-	dx := float64(center.X) - player.Object.Position.X
-	dy := float64(center.Y) - player.Object.Position.Y
+	position := player.Position()
+	dx := float64(center.X) - position.X
+	dy := float64(center.Y) - position.Y
 	return math.Atan2(dy, dx) + RotationOffset
 }
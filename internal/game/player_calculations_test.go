@@ -1,7 +1,9 @@
 package game
 
 import (
+	"image"
 	"log/slog"
+	"math"
 	"reflect"
 	"testing"
 
@@ -186,3 +188,184 @@ func TestPlayer_calculatePosition(t *testing.T) {
 		})
 	}
 }
+
+func TestPlayer_calculateCoordinates_innerDeadRadius(t *testing.T) {
+	withConfig(t)
+
+	radius = 10
+	GameConfig.InnerDeadRadius = 100
+
+	if got := orbitRadius(); got < GameConfig.InnerDeadRadius {
+		t.Fatalf("orbitRadius() = %v, want at least %v", got, GameConfig.InnerDeadRadius)
+	}
+
+	player := &Player{}
+	for angle := 0.0; angle < 2*math.Pi; angle += AngleStep {
+		x, y := player.calculateCoordinates(angle)
+		dx := float64(x - center.X)
+		dy := float64(y + playerHeight/2 - center.Y)
+		// Allow for the integer truncation of each coordinate.
+		if got := math.Hypot(dx, dy); got < GameConfig.InnerDeadRadius-math.Sqrt2 {
+			t.Errorf("radius at angle %v = %v, want at least %v", angle, got, GameConfig.InnerDeadRadius)
+		}
+	}
+}
+
+func TestSetOrbitRadius(t *testing.T) {
+	withConfig(t)
+
+	game, err := NewGimlarGame(1.0)
+	if err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+	game.player.viewAngle = math.Pi / 3
+
+	for _, r := range []float64{100, 150, 180} {
+		if err := game.SetOrbitRadius(r); err != nil {
+			t.Fatalf("SetOrbitRadius(%v) error = %v", r, err)
+		}
+
+		wantX, wantY := game.player.calculateCoordinates(math.Pi / 3)
+		if got := game.player.Position(); got.X != float64(wantX) || got.Y != float64(wantY) {
+			t.Errorf("SetOrbitRadius(%v) position = %v, want (%v, %v)", r, got, wantX, wantY)
+		}
+		if got := game.GetRadius(); got != r {
+			t.Errorf("GetRadius() = %v, want %v", got, r)
+		}
+	}
+
+	// An orbit that would leave the screen is clamped
+	if err := game.SetOrbitRadius(1000); err != nil {
+		t.Fatalf("SetOrbitRadius(1000) error = %v", err)
+	}
+	if got := game.GetRadius(); got != maxOrbitRadius() {
+		t.Errorf("GetRadius() = %v, want %v", got, maxOrbitRadius())
+	}
+
+	if err := game.SetOrbitRadius(0); err == nil {
+		t.Error("SetOrbitRadius(0) error = nil, want error")
+	}
+}
+
+func TestMaxOrbitRadius_keepsSpriteOnScreen(t *testing.T) {
+	withConfig(t)
+
+	corners := [][2]float64{{0, 0}, {playerSpriteSize.X, 0}, {0, playerSpriteSize.Y}, {playerSpriteSize.X, playerSpriteSize.Y}}
+	for _, c := range []image.Point{{X: screenWidth / 2, Y: screenHeight / 2}, {X: 420, Y: 190}, {X: 200, Y: 330}} {
+		center = c
+		radius = 1000
+
+		player := &Player{}
+		for angle := 0.0; angle < 2*math.Pi; angle += AngleStep {
+			x, y := player.calculateCoordinates(angle)
+			// Turn the sprite every way around its center, like drawSprite does
+			for turn := 0.0; turn < 2*math.Pi; turn += math.Pi / 16 {
+				var geoM ebiten.GeoM
+				geoM.Translate(-playerSpriteSize.X/2, -playerSpriteSize.Y/2)
+				geoM.Rotate(turn)
+				geoM.Translate(float64(x), float64(y))
+				for _, corner := range corners {
+					cx, cy := geoM.Apply(corner[0], corner[1])
+					if cx < 0 || cx > screenWidth || cy < 0 || cy > screenHeight {
+						t.Errorf("center %v, angle %v, turn %v: sprite corner (%v, %v) is off screen", c, angle, turn, cx, cy)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSetOrbitRadius_freeFlight(t *testing.T) {
+	withConfig(t)
+
+	GameConfig.ControlScheme = ControlSchemeFreeFlight
+
+	game, err := NewGimlarGame(1.0)
+	if err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+	game.player.moveTo(screenWidth/4, screenHeight/4)
+
+	// Free flight players aren't pulled onto the orbit
+	if err := game.SetOrbitRadius(100); err != nil {
+		t.Fatalf("SetOrbitRadius(100) error = %v", err)
+	}
+	if got := game.player.Position(); got.X != screenWidth/4 || got.Y != screenHeight/4 {
+		t.Errorf("position = %v, want (%v, %v)", got, screenWidth/4, screenHeight/4)
+	}
+	if got := game.GetRadius(); got != 100 {
+		t.Errorf("GetRadius() = %v, want 100", got)
+	}
+}
+
+func TestApplyOrbitConfig(t *testing.T) {
+	withConfig(t)
+
+	tests := []struct {
+		name    string
+		ratio   float64
+		offset  image.Point
+		scheme  ControlScheme
+		wantErr bool
+	}{
+		{name: "default", ratio: 0.75},
+		{name: "offset center", ratio: 0.5, offset: image.Point{X: 100, Y: -50}},
+		{name: "zero ratio", ratio: 0, wantErr: true},
+		{name: "ratio above one", ratio: 1.5, wantErr: true},
+		{name: "orbit leaves the screen", ratio: 0.9, offset: image.Point{X: 100, Y: -50}, wantErr: true},
+		{name: "center off screen", ratio: 0.5, offset: image.Point{X: screenWidth / 2}, wantErr: true},
+		{name: "no room outside dead radius", ratio: 0.5, offset: image.Point{Y: screenHeight/2 - 20}, wantErr: true},
+		{name: "orbit near the bottom", ratio: 0.1, offset: image.Point{Y: 175}},
+		{name: "no room outside dead radius in free flight", ratio: 0.1, offset: image.Point{Y: 175}, scheme: ControlSchemeFreeFlight, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.OrbitRadiusRatio = tt.ratio
+			cfg.OrbitCenterOffset = tt.offset
+			if tt.scheme != "" {
+				cfg.ControlScheme = tt.scheme
+			}
+
+			err := applyOrbitConfig(cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("applyOrbitConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				wantCenter := image.Point{X: screenWidth/2 + tt.offset.X, Y: screenHeight/2 + tt.offset.Y}
+				if center != wantCenter {
+					t.Errorf("center = %v, want %v", center, wantCenter)
+				}
+				if want := float64(screenHeight/2) * tt.ratio; radius != want {
+					t.Errorf("radius = %v, want %v", radius, want)
+				}
+			}
+		})
+	}
+}
+
+func TestPlayer_calculateAngle_offsetCenter(t *testing.T) {
+	withConfig(t)
+
+	GameConfig.OrbitRadiusRatio = 0.5
+	GameConfig.OrbitCenterOffset = image.Point{X: 100, Y: -50}
+
+	game, err := NewGimlarGame(1.0)
+	if err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+
+	// On the left of the orbit, centered at (420, 190) with radius 120
+	game.player.viewAngle = math.Pi
+	game.player.Reposition()
+
+	want := resolv.Vector{X: 300, Y: 182}
+	if got := game.player.Position(); got != want {
+		t.Errorf("position = %v, want %v", got, want)
+	}
+
+	// The player faces the configured center, to its right
+	if want := math.Atan2(8, 120) + RotationOffset; math.Abs(game.player.angle-want) > 1e-9 {
+		t.Errorf("angle = %v, want %v", game.player.angle, want)
+	}
+}
@@ -2,7 +2,9 @@ package game
 
 import (
 	_ "image/png"
+	"math"
 	"testing"
+	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/solarlune/resolv"
@@ -107,3 +109,172 @@ func TestPlayer_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestPlayer_hitboxSize(t *testing.T) {
+	withConfig(t)
+
+	GameConfig.PlayerHitboxWidth = 6
+	GameConfig.PlayerHitboxHeight = 4
+
+	image := ebiten.NewImage(600, 480)
+	p, err := NewPlayer(NewMockHandler(), 1.0, image)
+	if err != nil {
+		t.Fatalf("Failed to create new player: %v", err)
+	}
+
+	want := resolv.Vector{X: 6, Y: 4}
+	if p.Object.Size != want {
+		t.Errorf("NewPlayer() hitbox size = %v, want %v", p.Object.Size, want)
+	}
+
+	p.Update()
+	if p.Object.Size != want {
+		t.Errorf("Player.Update() hitbox size = %v, want %v", p.Object.Size, want)
+	}
+
+	// The sprite is drawn at a tenth of its size
+	sprite := drawnSpriteSize(p.Sprite)
+	if sprite != (resolv.Vector{X: 60, Y: 48}) {
+		t.Errorf("drawn sprite size = %v, want 60x48", sprite)
+	}
+	if p.Object.Size.X >= sprite.X || p.Object.Size.Y >= sprite.Y {
+		t.Errorf("hitbox %v is not smaller than the drawn sprite %v", p.Object.Size, sprite)
+	}
+
+	// The hitbox is centered on the player
+	if got := p.Object.Position.Add(p.Object.Size.Scale(0.5)); got != p.Position() {
+		t.Errorf("hitbox center = %v, want %v", got, p.Position())
+	}
+}
+
+func TestPlayer_ReverseControls(t *testing.T) {
+	withConfig(t)
+
+	const reversedTicks = 3
+	GameConfig.ReverseControlsDuration = reversedTicks * time.Second / time.Duration(ebiten.TPS())
+
+	input := NewMockHandler()
+	image := ebiten.NewImage(600, 480)
+	p, err := NewPlayer(input, 1.0, image)
+	if err != nil {
+		t.Fatalf("Failed to create new player: %v", err)
+	}
+
+	input.PressKey(ebiten.KeyLeft)
+	p.ReverseControls()
+
+	for i := 0; i < reversedTicks; i++ {
+		if !p.ControlsReversed() {
+			t.Fatalf("update %d: controls not reversed", i)
+		}
+		before := p.viewAngle
+		p.Update()
+		if p.direction != 1 || p.viewAngle <= before {
+			t.Errorf("update %d: direction = %v, viewAngle %v -> %v, want movement opposite to input", i, p.direction, before, p.viewAngle)
+		}
+	}
+
+	if p.ControlsReversed() {
+		t.Fatal("controls still reversed after the configured duration")
+	}
+	before := p.viewAngle
+	p.Update()
+	if p.direction != -1 || p.viewAngle >= before {
+		t.Errorf("direction = %v, viewAngle %v -> %v, want movement following input", p.direction, before, p.viewAngle)
+	}
+}
+
+func TestPlayer_Update_freeFlight(t *testing.T) {
+	withConfig(t)
+
+	GameConfig.ControlScheme = ControlSchemeFreeFlight
+	GameConfig.FreeFlightSpeed = 4
+
+	input := NewMockHandler()
+	image := ebiten.NewImage(600, 480)
+	p, err := NewPlayer(input, 1.0, image)
+	if err != nil {
+		t.Fatalf("Failed to create new player: %v", err)
+	}
+	p.Object = newPlayerHitbox(screenWidth/2, screenHeight/2)
+
+	// Diagonal movement is normalized and faces the direction of travel
+	input.PressKey(ebiten.KeyRight)
+	input.PressKey(ebiten.KeyDown)
+	p.Update()
+
+	step := 4 / math.Sqrt2
+	if got := p.Position(); math.Abs(got.X-(screenWidth/2+step)) > 1e-9 || math.Abs(got.Y-(screenHeight/2+step)) > 1e-9 {
+		t.Errorf("position = %v, want (%v, %v)", got, screenWidth/2+step, screenHeight/2+step)
+	}
+	if want := math.Pi/4 + RotationOffset; math.Abs(p.angle-want) > 1e-9 {
+		t.Errorf("angle = %v, want %v", p.angle, want)
+	}
+
+	// Releasing the keys keeps the position and facing
+	input.ReleaseKey(ebiten.KeyRight)
+	input.ReleaseKey(ebiten.KeyDown)
+	position, angle := p.Position(), p.angle
+	p.Update()
+	if p.Position() != position || p.angle != angle {
+		t.Errorf("idle update moved the player from %v/%v to %v/%v", position, angle, p.Position(), p.angle)
+	}
+
+	// Movement is clamped to the screen
+	margin := playerMargin()
+	p.Object = newPlayerHitbox(margin+1, margin+1)
+	input.PressKey(ebiten.KeyLeft)
+	input.PressKey(ebiten.KeyUp)
+	p.Update()
+	if got := p.Position(); got.X != margin || got.Y != margin {
+		t.Errorf("position = %v, want (%v, %v)", got, margin, margin)
+	}
+}
+
+func TestPlayer_Update_freeFlightInnerDeadRadius(t *testing.T) {
+	withConfig(t)
+
+	GameConfig.ControlScheme = ControlSchemeFreeFlight
+	GameConfig.FreeFlightSpeed = 3
+
+	input := NewMockHandler()
+	image := ebiten.NewImage(600, 480)
+	p, err := NewPlayer(input, 1.0, image)
+	if err != nil {
+		t.Fatalf("Failed to create new player: %v", err)
+	}
+
+	// Flying straight at the center stops at the inner dead radius
+	p.Object = newPlayerHitbox(float64(center.X)-100, float64(center.Y)+10)
+	input.PressKey(ebiten.KeyRight)
+	for i := 0; i < 100; i++ {
+		p.Update()
+
+		position := p.Position()
+		if got := math.Hypot(position.X-float64(center.X), position.Y-float64(center.Y)); got < GameConfig.InnerDeadRadius-1e-9 {
+			t.Fatalf("update %d: player at %v is %v from the center, want at least %v", i, position, got, GameConfig.InnerDeadRadius)
+		}
+	}
+
+	// The player slides around the dead radius and comes out the other side
+	if got := p.Position().X; got <= float64(center.X) {
+		t.Errorf("X = %v, want past the center at %v", got, center.X)
+	}
+}
+
+func TestPlayer_Update_orbitalIgnoresVerticalInput(t *testing.T) {
+	input := NewMockHandler()
+	image := ebiten.NewImage(600, 480)
+	p, err := NewPlayer(input, 1.0, image)
+	if err != nil {
+		t.Fatalf("Failed to create new player: %v", err)
+	}
+
+	viewAngle := p.viewAngle
+	input.PressKey(ebiten.KeyUp)
+	p.Update()
+
+	if p.viewAngle != viewAngle || p.direction != 0 {
+		t.Errorf("viewAngle = %v, direction = %v, want %v and 0", p.viewAngle, p.direction, viewAngle)
+	}
+}
@@ -0,0 +1,62 @@
+package game
+
+import (
+	"fmt"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/jonesrussell/gimbal/internal/logger"
+)
+
+const (
+	screenshotKey = ebiten.KeyF2
+	screenshotDir = "screenshots"
+)
+
+// screenshotFilename returns the file name of a screenshot taken at t.
+func screenshotFilename(t time.Time) string {
+	return fmt.Sprintf("gimbal-%s.png", t.Format("20060102-150405.000"))
+}
+
+// writeScreenshot encodes img as a PNG in dir, creating the directory if needed,
+// and returns the path of the written file.
+func writeScreenshot(dir string, img image.Image, t time.Time) (string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create screenshot directory: %w", err)
+	}
+
+	path := filepath.Join(dir, screenshotFilename(t))
+	f, err := os.Create(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to create screenshot: %w", err)
+	}
+	defer f.Close()
+
+	if err := png.Encode(f, img); err != nil {
+		return "", fmt.Errorf("failed to encode screenshot: %w", err)
+	}
+
+	return path, f.Close()
+}
+
+// captureScreenshot saves the drawn screen if a screenshot was requested.
+func (g *GimlarGame) captureScreenshot(screen *ebiten.Image) {
+	if !g.screenshotPending {
+		return
+	}
+	g.screenshotPending = false
+
+	img := image.NewRGBA(screen.Bounds())
+	screen.ReadPixels(img.Pix)
+
+	path, err := writeScreenshot(screenshotDir, img, time.Now())
+	if err != nil {
+		logger.GlobalLogger.Error("Failed to save screenshot", "error", err)
+		return
+	}
+	logger.GlobalLogger.Info("Saved screenshot", "path", path)
+}
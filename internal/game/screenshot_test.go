@@ -0,0 +1,58 @@
+package game
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScreenshotFilename(t *testing.T) {
+	at := time.Date(2024, time.March, 5, 14, 7, 9, 123000000, time.UTC)
+
+	assert.Equal(t, "gimbal-20240305-140709.123.png", screenshotFilename(at))
+}
+
+func TestWriteScreenshot(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 4, 3))
+	img.Set(1, 2, color.RGBA{255, 128, 0, 255})
+
+	dir := filepath.Join(t.TempDir(), screenshotDir)
+	at := time.Date(2024, time.March, 5, 14, 7, 9, 0, time.UTC)
+
+	path, err := writeScreenshot(dir, img, at)
+	if err != nil {
+		t.Fatalf("writeScreenshot() error = %v", err)
+	}
+	assert.Equal(t, filepath.Join(dir, screenshotFilename(at)), path)
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Failed to open screenshot: %v", err)
+	}
+	defer f.Close()
+
+	decoded, err := png.Decode(f)
+	if err != nil {
+		t.Fatalf("Failed to decode screenshot: %v", err)
+	}
+	assert.Equal(t, img.Bounds(), decoded.Bounds())
+	assert.Equal(t, color.RGBAModel.Convert(img.At(1, 2)), color.RGBAModel.Convert(decoded.At(1, 2)))
+}
+
+func TestUpdateDebugInput_screenshotKey(t *testing.T) {
+	input := NewMockHandler()
+	g := &GimlarGame{input: input}
+
+	g.updateDebugInput()
+	assert.False(t, g.screenshotPending)
+
+	input.PressKey(screenshotKey)
+	g.updateDebugInput()
+	assert.True(t, g.screenshotPending)
+}
@@ -0,0 +1,97 @@
+package game
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// InputFrame lists the keys held down during one frame of scripted input.
+type InputFrame struct {
+	Keys []ebiten.Key
+}
+
+// Hold returns a run of input frames that hold keys down for the given number of frames.
+func Hold(frames int, keys ...ebiten.Key) []InputFrame {
+	script := make([]InputFrame, frames)
+	for i := range script {
+		script[i] = InputFrame{Keys: keys}
+	}
+	return script
+}
+
+// ScriptedHandler plays back a scripted sequence of input frames for tests,
+// one frame per game update. It implements InputHandlerInterface.
+type ScriptedHandler struct {
+	frames   []InputFrame
+	frame    int
+	Bindings KeyBindings
+}
+
+// NewScriptedHandler creates a handler that plays back the given runs of frames in order.
+func NewScriptedHandler(frames ...[]InputFrame) *ScriptedHandler {
+	sh := &ScriptedHandler{}
+	for _, f := range frames {
+		sh.frames = append(sh.frames, f...)
+	}
+	return sh
+}
+
+// Next advances to the next frame of the script.
+func (sh *ScriptedHandler) Next() {
+	if sh.frame < len(sh.frames) {
+		sh.frame++
+	}
+}
+
+// Remaining returns the number of frames not yet played.
+func (sh *ScriptedHandler) Remaining() int {
+	return len(sh.frames) - sh.frame
+}
+
+// Done reports whether every frame of the script has been played.
+func (sh *ScriptedHandler) Done() bool {
+	return sh.Remaining() == 0
+}
+
+// held reports whether key is held in the given frame.
+func (sh *ScriptedHandler) held(frame int, key ebiten.Key) bool {
+	if frame < 0 || frame >= len(sh.frames) {
+		return false
+	}
+	return slices.Contains(sh.frames[frame].Keys, key)
+}
+
+func (sh *ScriptedHandler) IsKeyPressed(key ebiten.Key) bool {
+	return sh.held(sh.frame, key)
+}
+
+func (sh *ScriptedHandler) IsActionPressed(action Action) bool {
+	return sh.IsKeyPressed(sh.Bindings.Key(action))
+}
+
+func (sh *ScriptedHandler) WasKeyJustPressed(key ebiten.Key) bool {
+	return sh.held(sh.frame, key) && !sh.held(sh.frame-1, key)
+}
+
+// SetInputHandler replaces the input handler used by the game and the player.
+func (g *GimlarGame) SetInputHandler(input InputHandlerInterface) {
+	g.input = input
+	g.player.input = input
+}
+
+// RunScript plays a scripted input sequence through the game, running one
+// fixed step per frame until the script is done. It stops at and returns the first update error.
+func (g *GimlarGame) RunScript(script *ScriptedHandler) error {
+	g.SetInputHandler(script)
+
+	for !script.Done() {
+		if err := g.update(g.timestep.step); err != nil {
+			return fmt.Errorf("frame %d: %w", script.frame, err)
+		}
+		script.Next()
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScriptedHandler(t *testing.T) {
+	script := NewScriptedHandler(
+		Hold(2, ebiten.KeyLeft),
+		Hold(1, ebiten.KeyLeft, ebiten.KeyF3),
+		Hold(1),
+	)
+	assert.Equal(t, 4, script.Remaining())
+
+	assert.True(t, script.IsActionPressed(ActionMoveLeft))
+	assert.True(t, script.WasKeyJustPressed(ebiten.KeyLeft))
+
+	// Holding a key only registers as just pressed on its first frame
+	script.Next()
+	assert.True(t, script.IsActionPressed(ActionMoveLeft))
+	assert.False(t, script.WasKeyJustPressed(ebiten.KeyLeft))
+
+	script.Next()
+	assert.True(t, script.WasKeyJustPressed(ebiten.KeyF3))
+
+	script.Next()
+	assert.False(t, script.IsKeyPressed(ebiten.KeyLeft))
+	assert.False(t, script.Done())
+
+	script.Next()
+	assert.True(t, script.Done())
+	assert.False(t, script.IsKeyPressed(ebiten.KeyLeft))
+}
+
+func TestRunScript_moveThenToggleDebug(t *testing.T) {
+	withConfig(t)
+
+	prevDebug := Debug
+	defer func() {
+		Debug = prevDebug
+	}()
+	Debug = false
+
+	game, err := NewGimlarGame(1.0)
+	if err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+	startAngle := game.player.viewAngle
+
+	// Move left for five frames, then right for two, then toggle debug mode
+	script := NewScriptedHandler(
+		Hold(5, ebiten.KeyLeft),
+		Hold(2, ebiten.KeyRight),
+		Hold(3, ebiten.KeyF3),
+	)
+
+	assert.NoError(t, game.RunScript(script))
+	assert.True(t, script.Done())
+
+	assert.InDelta(t, startAngle-3*AngleStep, game.player.viewAngle, 1e-9)
+	assert.True(t, Debug) // Held for three frames, toggled once
+}
@@ -0,0 +1,91 @@
+package game
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// UserSettings are the settings players can change, saved between sessions.
+type UserSettings struct {
+	Resolution    Resolution
+	Fullscreen    bool
+	Palette       string
+	ReduceMotion  bool
+	ControlScheme ControlScheme
+	// KeyBindings override the bindings in the key bindings file when set.
+	KeyBindings KeyBindings `json:",omitempty"`
+}
+
+// UserSettings returns the user settings of the configuration.
+func (c Config) UserSettings() UserSettings {
+	return UserSettings{
+		Resolution:    c.Resolution,
+		Fullscreen:    c.Fullscreen,
+		Palette:       c.Palette,
+		ReduceMotion:  c.ReduceMotion,
+		ControlScheme: c.ControlScheme,
+		KeyBindings:   c.KeyBindings,
+	}
+}
+
+// Apply copies the settings into cfg.
+func (s UserSettings) Apply(cfg *Config) {
+	cfg.Resolution = s.Resolution
+	cfg.Fullscreen = s.Fullscreen
+	cfg.Palette = s.Palette
+	cfg.ReduceMotion = s.ReduceMotion
+	cfg.ControlScheme = s.ControlScheme
+	cfg.KeyBindings = s.KeyBindings
+}
+
+// UserSettings returns the game's current user settings, including the window
+// size and mode it was switched to while running.
+func (g *GimlarGame) UserSettings() UserSettings {
+	settings := GameConfig.UserSettings()
+	settings.Resolution = g.resolution
+	settings.Fullscreen = g.fullscreen
+	return settings
+}
+
+// LoadUserSettings reads user settings from a JSON file and returns them merged over
+// the defaults, e.g. {"Palette": "colorblind"}. Settings missing from the file keep their
+// default, and saved key bindings are merged over the default bindings.
+// If the file does not exist, the default settings are returned. The settings are
+// not validated; callers validate the config they are applied to with Config.Validate.
+func LoadUserSettings(path string) (UserSettings, error) {
+	settings := DefaultConfig().UserSettings()
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return settings, nil
+	}
+	if err != nil {
+		return UserSettings{}, fmt.Errorf("failed to read user settings: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return UserSettings{}, fmt.Errorf("failed to parse user settings: %w", err)
+	}
+
+	if settings.KeyBindings != nil {
+		settings.KeyBindings = settings.KeyBindings.effective()
+	}
+
+	return settings, nil
+}
+
+// SaveUserSettings writes the user settings to a JSON file that LoadUserSettings can read.
+func SaveUserSettings(path string, settings UserSettings) error {
+	data, err := json.MarshalIndent(settings, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode user settings: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write user settings: %w", err)
+	}
+
+	return nil
+}
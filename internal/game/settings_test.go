@@ -0,0 +1,134 @@
+package game
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserSettings_roundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "settings.json")
+	settings := UserSettings{
+		Resolution:    Resolution{Width: 1280, Height: 960},
+		Fullscreen:    true,
+		Palette:       PaletteColorblind,
+		ReduceMotion:  true,
+		ControlScheme: ControlSchemeFreeFlight,
+		KeyBindings:   KeyBindings{ActionMoveLeft: ebiten.KeyA}.effective(),
+	}
+
+	assert.NoError(t, SaveUserSettings(path, settings))
+
+	got, err := LoadUserSettings(path)
+	assert.NoError(t, err)
+	assert.Equal(t, settings, got)
+
+	cfg := DefaultConfig()
+	got.Apply(&cfg)
+	assert.Equal(t, settings, cfg.UserSettings())
+}
+
+func TestLoadUserSettings(t *testing.T) {
+	defaults := DefaultConfig().UserSettings()
+
+	tests := []struct {
+		name    string
+		content string
+		want    func(*UserSettings)
+		wantErr bool
+		invalid bool
+	}{
+		{
+			name:    "override one setting",
+			content: `{"Palette": "colorblind"}`,
+			want:    func(s *UserSettings) { s.Palette = PaletteColorblind },
+		},
+		{
+			name:    "override one key binding",
+			content: `{"KeyBindings": {"MoveLeft": "A"}}`,
+			want: func(s *UserSettings) {
+				s.KeyBindings = KeyBindings{ActionMoveLeft: ebiten.KeyA}.effective()
+			},
+		},
+		{
+			name:    "unknown palette",
+			content: `{"Palette": "neon"}`,
+			invalid: true,
+		},
+		{
+			name:    "resolution too small",
+			content: `{"Resolution": {"Width": 100, "Height": 100}}`,
+			invalid: true,
+		},
+		{
+			name:    "unknown control scheme",
+			content: `{"ControlScheme": "joystick"}`,
+			invalid: true,
+		},
+		{
+			name:    "duplicate key",
+			content: `{"KeyBindings": {"MoveLeft": "ArrowRight"}}`,
+			invalid: true,
+		},
+		{
+			name:    "malformed",
+			content: `{"Palette": `,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "settings.json")
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatalf("Failed to write settings: %v", err)
+			}
+
+			got, err := LoadUserSettings(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadUserSettings() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			// Invalid values load, but the config they are applied to fails validation.
+			cfg := DefaultConfig()
+			got.Apply(&cfg)
+			if err := cfg.Validate(); (err != nil) != tt.invalid {
+				t.Fatalf("Config.Validate() error = %v, invalid %v", err, tt.invalid)
+			}
+			if !tt.invalid {
+				want := defaults
+				tt.want(&want)
+				assert.Equal(t, want, got)
+			}
+		})
+	}
+}
+
+func TestLoadUserSettings_missingFile(t *testing.T) {
+	got, err := LoadUserSettings(filepath.Join(t.TempDir(), "missing.json"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultConfig().UserSettings(), got)
+}
+
+func TestGimlarGame_UserSettings(t *testing.T) {
+	withConfig(t)
+	GameConfig.Palette = PaletteColorblind
+
+	game, err := NewGimlarGame(1.0)
+	if err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+	assert.NoError(t, game.SetResolution(960, 720))
+	game.SetFullscreen(true)
+
+	want := GameConfig.UserSettings()
+	want.Resolution = Resolution{Width: 960, Height: 720}
+	want.Fullscreen = true
+	assert.Equal(t, want, game.UserSettings())
+}
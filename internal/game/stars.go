@@ -1,6 +1,7 @@
 package game
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"math/rand"
@@ -8,12 +9,60 @@ import (
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
+const (
+	// reducedMotionStarSpeed scales star speed when the reduce motion setting is on.
+	reducedMotionStarSpeed = 0.25
+
+	maxStars = 1000
+
+	// maxStarSpeed is the fastest a star moves, in pixels per second.
+	maxStarSpeed = 120.0
+)
+
+// StarLayer configures one depth layer of the star field. Deeper layers move
+// slower and draw smaller and dimmer stars, which gives the field parallax.
+type StarLayer struct {
+	// SpeedScale multiplies the speed of the layer's stars.
+	SpeedScale float64
+	// SizeScale multiplies the size of the layer's stars.
+	SizeScale float64
+	// Brightness is the opacity, between 0 and 1, of the layer's stars.
+	Brightness float64
+}
+
+// nearStarLayer is used for stars without a configured layer.
+var nearStarLayer = StarLayer{SpeedScale: 1, SizeScale: 1, Brightness: 1}
+
+// Star is a star in the star field. Speed is in pixels per second.
 type Star struct {
 	X, Y, Size, Angle, Speed float64
+	Layer                    int
 	Image                    *ebiten.Image
 }
 
-func initializeStars(numStars int, starImage *ebiten.Image) []Star {
+// validateStarField checks the star count and layer settings.
+func validateStarField(numStars int, layers []StarLayer) error {
+	if numStars <= 0 || numStars > maxStars {
+		return fmt.Errorf("star count must be between 1 and %d, got %d", maxStars, numStars)
+	}
+	if len(layers) == 0 {
+		return errors.New("star field needs at least one layer")
+	}
+	if len(layers) > numStars {
+		return fmt.Errorf("star field has %d layers but only %d stars", len(layers), numStars)
+	}
+	for i, layer := range layers {
+		if layer.SpeedScale <= 0 || layer.SizeScale <= 0 {
+			return fmt.Errorf("star layer %d: speed and size scales must be greater than zero", i)
+		}
+		if layer.Brightness <= 0 || layer.Brightness > 1 {
+			return fmt.Errorf("star layer %d: brightness must be in (0, 1], got %v", i, layer.Brightness)
+		}
+	}
+	return nil
+}
+
+func initializeStars(numStars, numLayers int, starImage *ebiten.Image) []Star {
 	stars := make([]Star, numStars)
 	for i := range stars {
 		stars[i] = Star{
@@ -21,18 +70,34 @@ func initializeStars(numStars int, starImage *ebiten.Image) []Star {
 			Y:     float64(screenHeight) / 2,
 			Size:  rand.Float64()*5 + 1, // Add 1 to ensure the size is always greater than 0
 			Angle: rand.Float64() * 2 * math.Pi,
-			Speed: rand.Float64() * 2,
-			Image: starImage, // Assign the global starImage to each Star
+			Speed: rand.Float64() * maxStarSpeed,
+			Layer: i % numLayers, // Spread the stars evenly across the layers
+			Image: starImage,     // Assign the global starImage to each Star
 		}
 	}
 	return stars
 }
 
-func (g *GimlarGame) updateStars() {
+// starLayer returns the layer settings for a star.
+func (g *GimlarGame) starLayer(star Star) StarLayer {
+	if star.Layer < 0 || star.Layer >= len(g.starLayers) {
+		return nearStarLayer
+	}
+	return g.starLayers[star.Layer]
+}
+
+// updateStars moves the stars by the distance they cover in dt seconds.
+func (g *GimlarGame) updateStars(dt float64) {
+	speedScale := 1.0
+	if GameConfig.ReduceMotion {
+		speedScale = reducedMotionStarSpeed
+	}
+
 	for i := range g.stars {
-		// Update star position based on its angle and speed
-		g.stars[i].X += g.stars[i].Speed * math.Cos(g.stars[i].Angle)
-		g.stars[i].Y += g.stars[i].Speed * math.Sin(g.stars[i].Angle)
+		// Update star position based on its angle and speed, slowed down in deeper layers
+		distance := g.stars[i].Speed * speedScale * g.starLayer(g.stars[i]).SpeedScale * dt
+		g.stars[i].X += distance * math.Cos(g.stars[i].Angle)
+		g.stars[i].Y += distance * math.Sin(g.stars[i].Angle)
 
 		// If star goes off screen, reset it to the center
 		if g.stars[i].X < 0 || g.stars[i].X > float64(screenWidth) || g.stars[i].Y < 0 || g.stars[i].Y > float64(screenHeight) {
@@ -40,23 +105,26 @@ func (g *GimlarGame) updateStars() {
 			g.stars[i].Y = float64(screenHeight) / 2
 			g.stars[i].Size = rand.Float64() * 5
 			g.stars[i].Angle = rand.Float64() * 2 * math.Pi
-			g.stars[i].Speed = rand.Float64() * 2
+			g.stars[i].Speed = rand.Float64() * maxStarSpeed
 		}
 	}
 }
 
 func (g *GimlarGame) drawStars(screen *ebiten.Image) {
 	for _, star := range g.stars {
+		layer := g.starLayer(star)
+
 		// Calculate the size of the star image
-		size := int(star.Size * 2)
+		size := int(star.Size * 2 * layer.SizeScale)
 		if size < 1 {
 			size = 1
 		}
 
-		// Create an option to position the star
+		// Create an option to position the star, dimmed by its layer
 		op := &ebiten.DrawImageOptions{}
 		op.GeoM.Scale(float64(size), float64(size))
 		op.GeoM.Translate(star.X-float64(size)/2, star.Y-float64(size)/2)
+		op.ColorScale.ScaleAlpha(float32(layer.Brightness))
 
 		// Draw the star using the Image field of the Star struct
 		screen.DrawImage(star.Image, op)
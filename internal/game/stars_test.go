@@ -0,0 +1,104 @@
+package game
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateStars_reduceMotion(t *testing.T) {
+	withConfig(t)
+
+	// A single star moving right from the center
+	start := Star{X: float64(screenWidth) / 2, Y: float64(screenHeight) / 2, Angle: 0, Speed: 2}
+	g := &GimlarGame{stars: []Star{start}}
+
+	GameConfig.ReduceMotion = false
+	g.updateStars(1)
+	fullStep := g.stars[0].X - start.X
+
+	g.stars[0] = start
+	GameConfig.ReduceMotion = true
+	g.updateStars(1)
+	reducedStep := g.stars[0].X - start.X
+
+	assert.InDelta(t, 2.0, fullStep, 1e-9)
+	assert.InDelta(t, fullStep*reducedMotionStarSpeed, reducedStep, 1e-9)
+}
+
+func TestValidateStarField(t *testing.T) {
+	layer := StarLayer{SpeedScale: 1, SizeScale: 1, Brightness: 1}
+
+	tests := []struct {
+		name     string
+		numStars int
+		layers   []StarLayer
+		wantErr  bool
+	}{
+		{name: "default config", numStars: DefaultConfig().StarCount, layers: DefaultConfig().StarLayers},
+		{name: "maximum stars across layers", numStars: maxStars, layers: []StarLayer{layer, layer, layer}},
+		{name: "too many stars", numStars: maxStars + 1, layers: []StarLayer{layer, layer}, wantErr: true},
+		{name: "no stars", numStars: 0, layers: []StarLayer{layer}, wantErr: true},
+		{name: "no layers", numStars: 10, wantErr: true},
+		{name: "more layers than stars", numStars: 1, layers: []StarLayer{layer, layer}, wantErr: true},
+		{name: "zero speed", numStars: 10, layers: []StarLayer{{SpeedScale: 0, SizeScale: 1, Brightness: 1}}, wantErr: true},
+		{name: "zero size", numStars: 10, layers: []StarLayer{{SpeedScale: 1, SizeScale: 0, Brightness: 1}}, wantErr: true},
+		{name: "too bright", numStars: 10, layers: []StarLayer{{SpeedScale: 1, SizeScale: 1, Brightness: 1.5}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateStarField(tt.numStars, tt.layers)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateStarField() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestInitializeStars_layers(t *testing.T) {
+	stars := initializeStars(9, 3, starImage)
+
+	counts := make(map[int]int)
+	for _, star := range stars {
+		counts[star.Layer]++
+	}
+	assert.Equal(t, map[int]int{0: 3, 1: 3, 2: 3}, counts)
+}
+
+func TestUpdateStars_layerParallax(t *testing.T) {
+	near := Star{X: float64(screenWidth) / 2, Y: float64(screenHeight) / 2, Angle: 0, Speed: 2, Layer: 0}
+	far := near
+	far.Layer = 1
+
+	g := &GimlarGame{
+		stars: []Star{near, far},
+		starLayers: []StarLayer{
+			{SpeedScale: 1, SizeScale: 1, Brightness: 1},
+			{SpeedScale: 0.5, SizeScale: 0.5, Brightness: 0.5},
+		},
+	}
+	g.updateStars(1)
+
+	assert.InDelta(t, 2.0, g.stars[0].X-near.X, 1e-9)
+	assert.InDelta(t, 1.0, g.stars[1].X-far.X, 1e-9)
+}
+
+func TestUpdateStars_frameRateIndependent(t *testing.T) {
+	// A star moving diagonally away from the center at 60 pixels per second
+	start := Star{X: float64(screenWidth) / 2, Y: float64(screenHeight) / 2, Angle: math.Pi / 4, Speed: 60}
+
+	// Half a second at 30 and at 120 updates per second
+	slow := &GimlarGame{stars: []Star{start}}
+	for i := 0; i < 15; i++ {
+		slow.updateStars(1.0 / 30)
+	}
+	fast := &GimlarGame{stars: []Star{start}}
+	for i := 0; i < 60; i++ {
+		fast.updateStars(1.0 / 120)
+	}
+
+	assert.InDelta(t, slow.stars[0].X, fast.stars[0].X, 1e-9)
+	assert.InDelta(t, slow.stars[0].Y, fast.stars[0].Y, 1e-9)
+	assert.InDelta(t, 30, math.Hypot(fast.stars[0].X-start.X, fast.stars[0].Y-start.Y), 1e-9)
+}
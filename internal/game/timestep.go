@@ -0,0 +1,45 @@
+package game
+
+import "time"
+
+// maxStepsPerUpdate caps the fixed steps run in one update. When the game falls
+// further behind than this, the backlog is dropped instead of being caught up,
+// so slow frames can't snowball into ever longer updates.
+const maxStepsPerUpdate = 5
+
+// timestepSnapTolerance is how close a frame time has to be to the fixed step
+// to count as exactly one step. It absorbs timer jitter and display refresh rates
+// just off the tick rate, which would otherwise add up to an occasional extra step.
+const timestepSnapTolerance = 250 * time.Microsecond
+
+// fixedTimestep converts variable frame times into a whole number of fixed
+// simulation steps, carrying the remainder over to the next frame.
+type fixedTimestep struct {
+	step        time.Duration
+	maxSteps    int
+	accumulator time.Duration
+}
+
+// Advance adds the elapsed frame time and returns how many fixed steps to run.
+func (f *fixedTimestep) Advance(elapsed time.Duration) int {
+	if diff := elapsed - f.step; diff >= -timestepSnapTolerance && diff <= timestepSnapTolerance {
+		elapsed = f.step
+	}
+	f.accumulator += elapsed
+
+	steps := int(f.accumulator / f.step)
+	if steps > f.maxSteps {
+		// Drop the backlog, but keep the accumulator's phase within a step
+		f.accumulator %= f.step
+		return f.maxSteps
+	}
+
+	f.accumulator -= time.Duration(steps) * f.step
+	return steps
+}
+
+// Stalled reports whether elapsed is longer than the steps one update can catch up on,
+// as happens when the window loses focus or the process is suspended.
+func (f *fixedTimestep) Stalled(elapsed time.Duration) bool {
+	return elapsed > time.Duration(f.maxSteps)*f.step
+}
@@ -0,0 +1,99 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFixedTimestep_Advance(t *testing.T) {
+	ts := fixedTimestep{step: 10 * time.Millisecond, maxSteps: 5}
+
+	tests := []struct {
+		elapsed time.Duration
+		want    int
+	}{
+		{elapsed: 5 * time.Millisecond, want: 0},    // Not enough for a step yet
+		{elapsed: 5 * time.Millisecond, want: 1},    // Carried-over time completes a step
+		{elapsed: 25 * time.Millisecond, want: 2},   // 5ms left over
+		{elapsed: 5 * time.Millisecond, want: 1},    // Leftover plus this frame
+		{elapsed: 10 * time.Millisecond, want: 1},   // Exactly one step
+		{elapsed: 200 * time.Millisecond, want: 5},  // Capped, and the backlog is dropped
+		{elapsed: 10 * time.Millisecond, want: 1},   // Back to normal after the cap
+		{elapsed: 9900 * time.Microsecond, want: 1}, // Snapped to a step
+		{elapsed: 100 * time.Microsecond, want: 0},  // Nothing was carried over by the snap
+		{elapsed: 9900 * time.Microsecond, want: 1}, // Snapped again
+		{elapsed: 0, want: 0},
+	}
+	for i, tt := range tests {
+		assert.Equal(t, tt.want, ts.Advance(tt.elapsed), "frame %d (%v)", i, tt.elapsed)
+	}
+}
+
+func TestUpdate_fixedSteps(t *testing.T) {
+	withConfig(t)
+
+	game, err := NewGimlarGame(1.0)
+	if err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+	step := game.timestep.step
+
+	// Frames of two and a half steps, then a stall longer than the catch-up cap
+	for _, elapsed := range []time.Duration{step * 5 / 2, step * 5 / 2, step * 20} {
+		if err := game.update(elapsed); err != nil {
+			t.Fatalf("update(%v) error = %v", elapsed, err)
+		}
+	}
+
+	// The player records its position once per fixed step. The
+	// accumulator starts half a step in, so the first frame runs three
+	assert.Len(t, game.player.path, 3+2+maxStepsPerUpdate)
+}
+
+func TestUpdate_wallClock(t *testing.T) {
+	withConfig(t)
+
+	game, err := NewGimlarGame(1.0)
+	if err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+	step := game.timestep.step
+	now := time.Now()
+	game.clock = func() time.Time {
+		return now
+	}
+
+	// The first update runs a single step
+	assert.NoError(t, game.Update())
+	assert.Len(t, game.player.path, 1)
+
+	// Frames just off the step run one step each, without the jitter adding up to extra steps
+	const frames = 200
+	for i := 0; i < frames; i++ {
+		now = now.Add(step + 100*time.Microsecond)
+		assert.NoError(t, game.Update())
+	}
+	assert.Len(t, game.player.path, 1+frames)
+
+	// Jitter larger than the snap tolerance still runs exactly one step per frame
+	jitter := []time.Duration{time.Millisecond, -time.Millisecond, 2 * time.Millisecond, -2 * time.Millisecond, 0}
+	start := now
+	for i := 1; i <= frames; i++ {
+		now = start.Add(time.Duration(i)*step + jitter[i%len(jitter)])
+		before := len(game.player.path)
+		assert.NoError(t, game.Update())
+		assert.Len(t, game.player.path, before+1, "frame %d", i)
+	}
+	now = start.Add(time.Duration(frames) * step)
+
+	// A stall, e.g. from the window losing focus, resumes with a single step
+	now = now.Add(2 * time.Second)
+	assert.NoError(t, game.Update())
+	assert.Len(t, game.player.path, 1+2*frames+1)
+
+	now = now.Add(step * 2)
+	assert.NoError(t, game.Update())
+	assert.Len(t, game.player.path, 1+2*frames+1+2)
+}
@@ -0,0 +1,145 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+const (
+	trainingHintSpacing = 16
+	trainingFadeSeconds = 1.0
+)
+
+// trainingHint is a control hint that stays on screen until its action is first used.
+type trainingHint struct {
+	text   string
+	action Action
+	used   bool
+}
+
+// newTrainingHint creates a hint for action, labeled with the key bound to it.
+func newTrainingHint(bindings KeyBindings, action Action, description string) *trainingHint {
+	return &trainingHint{
+		text:   fmt.Sprintf("%s: %s", bindings.Key(action), description),
+		action: action,
+	}
+}
+
+// TrainingOverlay shows control hints to new players. Each hint disappears once its
+// control has been used, and the whole overlay fades out after the configured duration.
+type TrainingOverlay struct {
+	hints      []*trainingHint
+	ticksLeft  int
+	fadeTicks  int
+	hintsImage *ebiten.Image
+}
+
+// newTrainingHints creates the hints for the controls of the configured control scheme.
+func newTrainingHints(bindings KeyBindings) []*trainingHint {
+	if GameConfig.ControlScheme == ControlSchemeFreeFlight {
+		return []*trainingHint{
+			newTrainingHint(bindings, ActionMoveLeft, "move left"),
+			newTrainingHint(bindings, ActionMoveRight, "move right"),
+			newTrainingHint(bindings, ActionMoveUp, "move up"),
+			newTrainingHint(bindings, ActionMoveDown, "move down"),
+		}
+	}
+
+	return []*trainingHint{
+		newTrainingHint(bindings, ActionMoveLeft, "move clockwise"),
+		newTrainingHint(bindings, ActionMoveRight, "move counter-clockwise"),
+	}
+}
+
+// NewTrainingOverlay creates a training overlay with hints for the controls bound in bindings.
+func NewTrainingOverlay(bindings KeyBindings) *TrainingOverlay {
+	tps := float64(ebiten.TPS())
+	return &TrainingOverlay{
+		hints:      newTrainingHints(bindings),
+		ticksLeft:  int(math.Round(GameConfig.TrainingOverlayDuration.Seconds() * tps)),
+		fadeTicks:  int(math.Round(trainingFadeSeconds * tps)),
+		hintsImage: ebiten.NewImage(screenWidth, screenHeight),
+	}
+}
+
+// Update marks the hints whose actions are pressed as used and advances the fade out.
+func (t *TrainingOverlay) Update(input InputHandlerInterface) {
+	if t.ticksLeft > 0 {
+		t.ticksLeft--
+	}
+
+	for _, hint := range t.hints {
+		if input.IsActionPressed(hint.action) {
+			hint.used = true
+		}
+	}
+}
+
+// Done reports whether the overlay has finished showing.
+func (t *TrainingOverlay) Done() bool {
+	return t.ticksLeft <= 0
+}
+
+// VisibleHints returns the text of the hints that are still shown.
+func (t *TrainingOverlay) VisibleHints() []string {
+	if t.ticksLeft <= 0 {
+		return nil
+	}
+
+	var visible []string
+	for _, hint := range t.hints {
+		if !hint.used {
+			visible = append(visible, hint.text)
+		}
+	}
+	return visible
+}
+
+// Draw draws the visible hints centered near the bottom of the screen.
+func (t *TrainingOverlay) Draw(screen *ebiten.Image) {
+	visible := t.VisibleHints()
+	if len(visible) == 0 {
+		return
+	}
+
+	t.hintsImage.Clear()
+	for i, text := range visible {
+		// The debug font is 6 pixels wide per character.
+		x := (screenWidth - len(text)*6) / 2
+		y := screenHeight - trainingHintSpacing*(len(visible)-i+1)
+		ebitenutil.DebugPrintAt(t.hintsImage, text, x, y)
+	}
+
+	op := &ebiten.DrawImageOptions{}
+	if t.ticksLeft < t.fadeTicks {
+		op.ColorScale.ScaleAlpha(float32(t.ticksLeft) / float32(t.fadeTicks))
+	}
+	screen.DrawImage(t.hintsImage, op)
+}
+
+// trainingSeen reports whether the file at path records that the training overlay
+// was shown in an earlier playthrough. An empty path is never seen.
+func trainingSeen(path string) bool {
+	if path == "" {
+		return false
+	}
+	_, err := os.Stat(path)
+	return !errors.Is(err, os.ErrNotExist)
+}
+
+// markTrainingSeen records in the file at path that the training overlay has been shown.
+// Nothing is recorded for an empty path.
+func markTrainingSeen(path string) error {
+	if path == "" {
+		return nil
+	}
+	if err := os.WriteFile(path, nil, 0o644); err != nil {
+		return fmt.Errorf("failed to record the training overlay as seen: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrainingOverlay_hintDisappearsOnFirstUse(t *testing.T) {
+	input := NewMockHandler()
+	overlay := NewTrainingOverlay(DefaultKeyBindings())
+
+	overlay.Update(input)
+	assert.Len(t, overlay.VisibleHints(), 2)
+
+	// Using the left control only hides the left hint
+	input.PressKey(ebiten.KeyLeft)
+	overlay.Update(input)
+	assert.Equal(t, []string{"ArrowRight: move counter-clockwise"}, overlay.VisibleHints())
+
+	// The hint stays hidden after the key is released
+	input.ReleaseKey(ebiten.KeyLeft)
+	overlay.Update(input)
+	assert.Equal(t, []string{"ArrowRight: move counter-clockwise"}, overlay.VisibleHints())
+
+	input.PressKey(ebiten.KeyRight)
+	overlay.Update(input)
+	assert.Empty(t, overlay.VisibleHints())
+}
+
+func TestTrainingOverlay_expires(t *testing.T) {
+	withConfig(t)
+
+	GameConfig.TrainingOverlayDuration = 2 * time.Second / time.Duration(ebiten.TPS())
+
+	input := NewMockHandler()
+	overlay := NewTrainingOverlay(DefaultKeyBindings())
+
+	overlay.Update(input)
+	assert.NotEmpty(t, overlay.VisibleHints())
+
+	overlay.Update(input)
+	assert.Empty(t, overlay.VisibleHints())
+}
+
+func TestTrainingOverlay_labelsBoundKeys(t *testing.T) {
+	bindings := DefaultKeyBindings()
+	bindings[ActionMoveLeft] = ebiten.KeyA
+	bindings[ActionMoveRight] = ebiten.KeyD
+
+	overlay := NewTrainingOverlay(bindings)
+	overlay.Update(NewMockHandler())
+
+	assert.Equal(t, []string{"A: move clockwise", "D: move counter-clockwise"}, overlay.VisibleHints())
+}
+
+func TestTrainingOverlay_freeFlightHints(t *testing.T) {
+	withConfig(t)
+
+	GameConfig.ControlScheme = ControlSchemeFreeFlight
+
+	input := NewMockHandler()
+	overlay := NewTrainingOverlay(DefaultKeyBindings())
+	overlay.Update(input)
+	assert.Equal(t, []string{
+		"ArrowLeft: move left",
+		"ArrowRight: move right",
+		"ArrowUp: move up",
+		"ArrowDown: move down",
+	}, overlay.VisibleHints())
+
+	input.PressKey(ebiten.KeyUp)
+	overlay.Update(input)
+	assert.NotContains(t, overlay.VisibleHints(), "ArrowUp: move up")
+}
+
+func TestTrainingOverlay_firstPlaythroughOnly(t *testing.T) {
+	withConfig(t)
+
+	GameConfig.TrainingOverlayDuration = 2 * time.Second / time.Duration(ebiten.TPS())
+
+	game, err := NewGimlarGame(1.0)
+	if err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+	assert.NotNil(t, game.training)
+	assert.False(t, trainingSeen(GameConfig.TrainingSeenFile))
+
+	// The overlay is recorded as seen once it has finished showing
+	assert.NoError(t, game.RunHeadless(2))
+	assert.Nil(t, game.training)
+	assert.True(t, trainingSeen(GameConfig.TrainingSeenFile))
+
+	game, err = NewGimlarGame(1.0)
+	if err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+	assert.Nil(t, game.training)
+}
@@ -1,6 +1,7 @@
 package logger
 
 import (
+	"fmt"
 	"log/slog"
 	"os"
 	"runtime"
@@ -9,12 +10,37 @@ import (
 
 var GlobalLogger slog.Logger
 
+// globalLevel is the level of GlobalLogger. It can be changed at runtime with SetLevel.
+var globalLevel = new(slog.LevelVar)
+
 func init() {
-	// Initialize the global logger with default settings
-	GlobalLogger = NewSlogHandler(slog.LevelInfo)
+	// Initialize the global logger with default settings, honoring LOG_LEVEL when it is set
+	globalLevel.Set(slog.LevelInfo)
+	if env := os.Getenv("LOG_LEVEL"); env != "" {
+		if err := SetLevel(env); err != nil {
+			fmt.Fprintf(os.Stderr, "Ignoring LOG_LEVEL: %v\n", err)
+		}
+	}
+	GlobalLogger = NewSlogHandler(globalLevel)
+}
+
+// SetLevel changes the level of GlobalLogger without recreating it.
+// The level is a name such as "DEBUG", "INFO", "WARN" or "ERROR", case insensitive.
+func SetLevel(level string) error {
+	var l slog.Level
+	if err := l.UnmarshalText([]byte(level)); err != nil {
+		return fmt.Errorf("invalid log level %q: %w", level, err)
+	}
+	globalLevel.Set(l)
+	return nil
+}
+
+// Level returns the current level of GlobalLogger.
+func Level() slog.Level {
+	return globalLevel.Level()
 }
 
-func NewSlogHandler(level slog.Level) slog.Logger {
+func NewSlogHandler(level slog.Leveler) slog.Logger {
 	logHandler := slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
 		Level:     level, // Use the provided logging level
 		AddSource: true,
@@ -0,0 +1,29 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetLevel(t *testing.T) {
+	prevLevel := Level()
+	defer SetLevel(prevLevel.String())
+
+	ctx := context.Background()
+
+	assert.NoError(t, SetLevel("DEBUG"))
+	assert.Equal(t, slog.LevelDebug, Level())
+	assert.True(t, GlobalLogger.Enabled(ctx, slog.LevelDebug))
+
+	assert.NoError(t, SetLevel("error"))
+	assert.Equal(t, slog.LevelError, Level())
+	assert.False(t, GlobalLogger.Enabled(ctx, slog.LevelDebug))
+	assert.False(t, GlobalLogger.Enabled(ctx, slog.LevelWarn))
+	assert.True(t, GlobalLogger.Enabled(ctx, slog.LevelError))
+
+	assert.Error(t, SetLevel("LOUD"))
+	assert.Equal(t, slog.LevelError, Level())
+}